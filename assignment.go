@@ -3,6 +3,7 @@ package toscalib
 import (
 	"fmt"
 	"os"
+	"path"
 	"reflect"
 	"strconv"
 	"strings"
@@ -94,9 +95,16 @@ func (p *Assignment) UnmarshalYAML(unmarshal func(interface{}) error) error {
 		return nil
 	}
 
-	// Value is map of values
+	// Value is map of values, or a function with a map argument
 	var mmmmm map[string]interface{}
 	if err := unmarshal(&mmmmm); err == nil {
+		for k, v := range mmmmm {
+			if len(mmmmm) == 1 && isFunction(k) {
+				p.Function = k
+				p.Args = []interface{}{v}
+				return nil
+			}
+		}
 		p.Value = mmmmm
 		return nil
 	}
@@ -110,256 +118,431 @@ func newAssignmentFunc(val interface{}) *Assignment {
 	rval := reflect.ValueOf(val)
 	switch rval.Kind() {
 	case reflect.Map:
-		for k, v := range rval.Interface().(map[interface{}]interface{}) {
-			if !isFunction(k.(string)) {
+		for _, k := range rval.MapKeys() {
+			name, ok := k.Interface().(string)
+			if !ok || !isFunction(name) {
 				continue
 			}
 			// Convert it to a Assignment
-			rv := reflect.ValueOf(v)
-			switch rv.Kind() {
-			case reflect.String:
-				return &Assignment{Function: k.(string), Args: []interface{}{rv.Interface()}}
-			default:
-				return &Assignment{Function: k.(string), Args: rv.Interface().([]interface{})}
+			v := rval.MapIndex(k).Interface()
+			if args, ok := v.([]interface{}); ok {
+				return &Assignment{Function: name, Args: args}
 			}
+			return &Assignment{Function: name, Args: []interface{}{v}}
 		}
 	}
 	return nil
 }
 
-func (p *Assignment) lookupValueArg(arg string) interface{} {
-	v := reflect.ValueOf(p.Value)
+// lookupIndex returns the entry of a list or map value designated by the index argument
+func lookupIndex(value interface{}, arg interface{}) (interface{}, error) {
+	key := fmt.Sprint(arg)
+	v := reflect.ValueOf(value)
 	switch v.Kind() {
 	case reflect.Slice:
-		if i, err := strconv.ParseInt(arg, 10, 0); err == nil {
-			return v.Index(int(i)).Interface()
+		i, err := strconv.ParseInt(key, 10, 0)
+		if err != nil {
+			return nil, fmt.Errorf("%w: list index %q is not an integer", ErrInvalidArguments, key)
 		}
+		if i < 0 || int(i) >= v.Len() {
+			return nil, fmt.Errorf("%w: %d", ErrIndexOutOfRange, i)
+		}
+		return v.Index(int(i)).Interface(), nil
 	case reflect.Map:
-		return v.MapIndex(reflect.ValueOf(arg)).Interface()
+		kv := reflect.ValueOf(key)
+		if !kv.Type().AssignableTo(v.Type().Key()) {
+			return nil, fmt.Errorf("%w: %s", ErrKeyNotFound, key)
+		}
+		e := v.MapIndex(kv)
+		if !e.IsValid() {
+			return nil, fmt.Errorf("%w: %s", ErrKeyNotFound, key)
+		}
+		return e.Interface(), nil
 	}
-	return v.Interface()
+	return nil, fmt.Errorf("%w: can not index %T value with %q", ErrInvalidArguments, value, key)
 }
 
-func (p *Assignment) evaluate(std *ServiceTemplateDefinition, ctx, arg string) interface{} {
-	if arg != "" {
-		val := p.lookupValueArg(arg)
+// value evaluates the Assignment designated by ref, found by a get_property or get_attribute
+// function, and walks down the nested entries designated by the remaining function arguments.
+func (e *evaluator) value(ref string, p *Assignment, ctx string, indexes []interface{}) (interface{}, error) {
+	if err := e.enter(ref); err != nil {
+		return nil, err
+	}
+	defer e.leave()
+
+	val, err := e.eval(p, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, idx := range indexes {
+		if val, err = lookupIndex(val, idx); err != nil {
+			return nil, err
+		}
 
 		// handle the scenario when the property value is another
 		// function call.
 		if pa := newAssignmentFunc(val); pa != nil {
-			return pa.Evaluate(std, ctx)
-		}
-
-		if len(p.Args) != 0 {
-			tmp := clone(*p)
-			pa, _ := tmp.(Assignment)
-			pa.Value = val
-			return pa.lookupValueArg(p.Args[0].(string))
+			if val, err = e.eval(pa, ctx); err != nil {
+				return nil, err
+			}
 		}
-
-		return val
 	}
-	return p.Evaluate(std, ctx)
+	return val, nil
 }
 
-func getNTByArgs(std *ServiceTemplateDefinition, ctx string, args []interface{}) (*NodeTemplate, *NodeTemplate) {
-	nt := std.findNodeTemplate(args[0].(string), ctx)
+// node returns the node template designated by the first argument of a function
+func (e *evaluator) node(name, ctx string) (*NodeTemplate, error) {
+	nt := e.std.findNodeTemplate(name, ctx)
 	if nt == nil {
-		return nil, nil
+		if name == Self || name == Source || name == Target || name == Host {
+			return nil, fmt.Errorf("%w: %s in context %q", ErrNodeNotFound, name, ctx)
+		}
+		return nil, fmt.Errorf("%w: %s", ErrNodeNotFound, name)
 	}
+	e.trace.add("node %s", nt.Name)
+	return nt, nil
+}
 
-	if r := nt.GetRequirement(args[1].(string)); r != nil {
-		if rnt := std.GetNodeTemplate(r.Node); rnt != nil {
-			return nt, rnt
+// find looks up a property, or an attribute, of a node template, within the named capability
+// first when capname is provided. It also reports if the capability holds the value.
+func find(nt *NodeTemplate, key, capname string, attribute bool) (*Assignment, bool) {
+	if attribute {
+		if attr, ok := nt.Capabilities[capname].Attributes[key]; ok && capname != "" {
+			return &attr.Assignment, true
+		}
+		if attr := nt.findAttribute(key, ""); attr != nil {
+			return &attr.Assignment, false
 		}
+		return nil, false
 	}
-	return nt, nil
+	if prop, ok := nt.Capabilities[capname].Properties[key]; ok && capname != "" {
+		return &prop.Assignment, true
+	}
+	if prop := nt.findProperty(key, ""); prop != nil {
+		return &prop.Assignment, false
+	}
+	return nil, false
 }
 
-func (p *Assignment) evalConcat(std *ServiceTemplateDefinition, ctx string) interface{} {
+func (e *evaluator) evalConcat(p *Assignment, ctx string) (interface{}, error) {
 	var output string
+	var firstErr error
 	for _, val := range p.Args {
+		if val == nil {
+			// an unset value, such as a null in the template, is an empty string
+			continue
+		}
 		switch reflect.TypeOf(val).Kind() {
-		case reflect.String, reflect.Int:
-			output = fmt.Sprintf("%s%s", output, val)
 		case reflect.Map:
 			if pa := newAssignmentFunc(val); pa != nil {
-				if o := pa.Evaluate(std, ctx); o != nil {
-					output = fmt.Sprintf("%s%s", output, o)
+				o, err := e.eval(pa, ctx)
+				if err != nil && firstErr == nil {
+					firstErr = err
+				}
+				switch o := o.(type) {
+				case nil:
+				case []string:
+					// lists, such as the result of get_nodes_of_type, are comma separated
+					output = fmt.Sprintf("%s%s", output, strings.Join(o, ","))
+				default:
+					output = fmt.Sprintf("%s%v", output, o)
 				}
 			}
+		default:
+			output = fmt.Sprintf("%s%v", output, val)
 		}
 	}
-	return output
+	return output, firstErr
 }
 
-func (p *Assignment) evalToken(std *ServiceTemplateDefinition, ctx string) interface{} {
-	var output string
+func (e *evaluator) evalToken(p *Assignment, ctx string) (interface{}, error) {
 	var value string
-	var token string
-	for idx, val := range p.Args {
-		switch reflect.TypeOf(val).Kind() {
-		case reflect.String:
-			// the first and second arg must result be of type string or resolve
-			// to be a string.
-			if idx == 0 {
-				value = val.(string)
-			} else if idx == 1 {
-				token = val.(string)
-			}
-		case reflect.Map:
-			// the first input could actually be a lookup for the value
-			if idx == 0 {
-				if pa := newAssignmentFunc(val); pa != nil {
-					if o := pa.Evaluate(std, ctx); o != nil {
-						value = fmt.Sprintf("%s", o)
-					}
-				}
-			}
-		case reflect.Int:
-			// the 3rd arg must be an int
-			if idx == 2 && value != "" && token != "" {
-				output = strings.Split(value, token)[val.(int)]
-			}
+	switch val := p.Args[0].(type) {
+	case string:
+		value = val
+	default:
+		// the first input could actually be a lookup for the value
+		pa := newAssignmentFunc(val)
+		if pa == nil {
+			return nil, fmt.Errorf("%w: %v is not a string", ErrInvalidArguments, val)
+		}
+		o, err := e.eval(pa, ctx)
+		if err != nil {
+			return nil, err
+		}
+		if o != nil {
+			value = fmt.Sprintf("%s", o)
 		}
 	}
-	if output == "" {
-		return nil
+
+	token, ok := p.Args[1].(string)
+	if !ok {
+		return nil, fmt.Errorf("%w: token %v is not a string", ErrInvalidArguments, p.Args[1])
+	}
+	index, ok := p.Args[2].(int)
+	if !ok {
+		return nil, fmt.Errorf("%w: index %v is not an integer", ErrInvalidArguments, p.Args[2])
+	}
+	if value == "" || token == "" {
+		return nil, nil
+	}
+
+	parts := strings.Split(value, token)
+	if index < 0 || index >= len(parts) {
+		return nil, fmt.Errorf("%w: %d, %q has %d substrings", ErrIndexOutOfRange, index, value, len(parts))
+	}
+	if parts[index] == "" {
+		return nil, nil
 	}
-	return output
+	return parts[index], nil
 }
 
-func (p *Assignment) evalArtifact(std *ServiceTemplateDefinition, ctx string) interface{} {
-	nt, _ := getNTByArgs(std, ctx, p.Args)
-	if nt == nil {
-		return nil
+func (e *evaluator) evalArtifact(p *Assignment, ctx string) (interface{}, error) {
+	nt, err := e.node(get(0, p.Args), ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	if at, ok := nt.Artifacts[p.Args[1].(string)]; ok {
-		// set default location to the 'temp|tmp' directory to handle 'LOCAL_FILE' being specified
-		// or no location or deploy_path is specified.
-		location := os.TempDir()
-		if loc := get(2, p.Args); loc != "" && loc != LocalFile {
-			location = loc
-		} else if at.DeployPath != "" {
-			location = at.DeployPath
-		}
+	name := get(1, p.Args)
+	at, ok := nt.Artifacts[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrArtifactNotFound, name)
+	}
+	e.trace.add("artifact %s", name)
+
+	// set default location to the 'temp|tmp' directory to handle 'LOCAL_FILE' being specified
+	// or no location or deploy_path is specified.
+	location := os.TempDir()
+	if loc := get(2, p.Args); loc != "" && loc != LocalFile {
+		location = loc
+	} else if at.DeployPath != "" {
+		location = at.DeployPath
+	}
 
-		destFile, err := copyFile(at.File, location)
-		if err != nil {
-			return nil
+	// the files are read and written within the sandbox of the template
+	var data []byte
+	if at.Repository != "" {
+		data, err = e.std.resolveRepositoryFile(e.retrievalContext(), at.Repository, at.File)
+	} else {
+		data, err = e.std.Sandbox.readFile(at.File)
+	}
+	var destFile string
+	if err == nil {
+		destFile, err = e.std.Sandbox.writeFile(path.Base(at.File), data, location)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("copying artifact %s: %w", name, err)
+	}
+	return destFile, nil
+}
+
+func (e *evaluator) evalInput(p *Assignment) (interface{}, error) {
+	name := get(0, p.Args)
+	if e.workflow != "" {
+		if in, ok := e.std.TopologyTemplate.Workflows[e.workflow].Inputs[name]; ok {
+			e.trace.add("workflow %s input %s", e.workflow, name)
+			return e.input("workflow "+e.workflow+" input "+name, in)
 		}
-		return destFile
 	}
-	return nil
+	in, ok := e.std.TopologyTemplate.Inputs[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrInputNotFound, name)
+	}
+	e.trace.add("input %s", name)
+	return e.input("input "+name, in)
 }
 
-func (p *Assignment) evalProperty(std *ServiceTemplateDefinition, ctx string) interface{} {
-	nt, rnt := getNTByArgs(std, ctx, p.Args)
-	if nt == nil {
-		return nil
+// input returns the value of an input, or its default when no value is assigned
+func (e *evaluator) input(ref string, in PropertyDefinition) (interface{}, error) {
+	if in.Value.Function == "" && in.Value.Value == nil && in.Default != nil {
+		return in.Default, nil
+	}
+	return e.value(ref, &in.Value.Assignment, "", nil)
+}
+
+// evalEntity handles get_property and get_attribute which share the same arguments:
+// the entity, an optional requirement or capability name, the name and nested indexes.
+func (e *evaluator) evalEntity(p *Assignment, ctx string, attribute bool) (interface{}, error) {
+	nt, err := e.node(get(0, p.Args), ctx)
+	if err != nil {
+		return nil, err
 	}
 
+	kind, what := ErrPropertyNotFound, "property"
+	if attribute {
+		kind, what = ErrAttributeNotFound, "attribute"
+	}
+	ref := func(nt *NodeTemplate, capname, key string) string {
+		if capname != "" {
+			return fmt.Sprintf("node %s capability %s %s %s", nt.Name, capname, what, key)
+		}
+		return fmt.Sprintf("node %s %s %s", nt.Name, what, key)
+	}
+
+	first := fmt.Sprint(p.Args[1])
 	if len(p.Args) == 2 {
-		if prop := nt.findProperty(p.Args[1].(string), ""); prop != nil {
-			return prop.evaluate(std, nt.Name, "")
+		if a, _ := find(nt, first, "", attribute); a != nil {
+			return e.value(ref(nt, "", first), a, nt.Name, nil)
 		}
+		return nil, fmt.Errorf("%w: %s on node %s", kind, first, nt.Name)
 	}
-	if len(p.Args) >= 3 {
-		if rnt != nil {
-			if prop := rnt.findProperty(p.Args[2].(string), p.Args[1].(string)); prop != nil {
-				prop.Args = remainder(3, p.Args)
-				return prop.evaluate(std, rnt.Name, get(3, p.Args))
+
+	second := fmt.Sprint(p.Args[2])
+	if r := nt.GetRequirement(first); r != nil {
+		if rnt := e.std.GetNodeTemplate(r.Node); rnt != nil {
+			if a, inCap := find(rnt, second, first, attribute); a != nil {
+				e.trace.add("requirement %s", first)
+				e.trace.add("node %s", rnt.Name)
+				if !inCap {
+					return e.value(ref(rnt, "", second), a, rnt.Name, p.Args[3:])
+				}
+				e.trace.add("capability %s", first)
+				return e.value(ref(rnt, first, second), a, rnt.Name, p.Args[3:])
 			}
 		}
-		if prop := nt.findProperty(p.Args[2].(string), p.Args[1].(string)); prop != nil {
-			prop.Args = remainder(3, p.Args)
-			return prop.evaluate(std, nt.Name, get(3, p.Args))
-		}
-		if prop := nt.findProperty(p.Args[1].(string), ""); prop != nil {
-			prop.Args = remainder(2, p.Args)
-			return prop.evaluate(std, nt.Name, get(2, p.Args))
+	}
+	if a, inCap := find(nt, second, first, attribute); a != nil {
+		if !inCap {
+			return e.value(ref(nt, "", second), a, nt.Name, p.Args[3:])
 		}
+		e.trace.add("capability %s", first)
+		return e.value(ref(nt, first, second), a, nt.Name, p.Args[3:])
 	}
-	return nil
+	if a, _ := find(nt, first, "", attribute); a != nil {
+		return e.value(ref(nt, "", first), a, nt.Name, p.Args[2:])
+	}
+	return nil, fmt.Errorf("%w: %s or %s.%s on node %s", kind, first, first, second, nt.Name)
 }
 
-func (p *Assignment) evalAttribute(std *ServiceTemplateDefinition, ctx string) interface{} {
-	nt, rnt := getNTByArgs(std, ctx, p.Args)
-	if nt == nil {
-		return nil
+func (e *evaluator) evalOperationOutput(p *Assignment, ctx string) (interface{}, error) {
+	entity := e.std.operationEntity(get(0, p.Args), ctx)
+	if entity == "" {
+		return nil, fmt.Errorf("%w: %s in context %q", ErrNodeNotFound, get(0, p.Args), ctx)
 	}
-
-	if len(p.Args) == 2 {
-		if attr := nt.findAttribute(p.Args[1].(string), ""); attr != nil {
-			return attr.evaluate(std, nt.Name, "")
-		}
+	if e.std.OperationOutputs == nil {
+		return nil, fmt.Errorf("%w: no operation output store", ErrOperationOutputNotFound)
 	}
-	if len(p.Args) >= 3 {
-		if rnt != nil {
-			if attr := rnt.findAttribute(p.Args[2].(string), p.Args[1].(string)); attr != nil {
-				attr.Args = remainder(3, p.Args)
-				return attr.evaluate(std, rnt.Name, get(3, p.Args))
-			}
-		}
-		if attr := nt.findAttribute(p.Args[2].(string), p.Args[1].(string)); attr != nil {
-			attr.Args = remainder(3, p.Args)
-			return attr.evaluate(std, nt.Name, get(3, p.Args))
-		}
-		if attr := nt.findAttribute(p.Args[1].(string), ""); attr != nil {
-			attr.Args = remainder(2, p.Args)
-			return attr.evaluate(std, nt.Name, get(2, p.Args))
-		}
+	v, ok := e.std.OperationOutputs.GetOutput(entity, get(1, p.Args), get(2, p.Args), get(3, p.Args))
+	if !ok {
+		return nil, fmt.Errorf("%w: %s %s.%s %s", ErrOperationOutputNotFound, entity, get(1, p.Args), get(2, p.Args), get(3, p.Args))
 	}
-	return nil
+	return v, nil
 }
 
-// Evaluate gets the value of an Assignment, including the evaluation of expression or function
-func (p *Assignment) Evaluate(std *ServiceTemplateDefinition, ctx string) interface{} {
-	// TODO(kenjones): Add support for the evaluation of ConstraintClause
+// eval gets the value of an Assignment. When a function fails the error is returned along with
+// the value computed so far, to preserve the lenient behavior of Evaluate.
+func (e *evaluator) eval(p *Assignment, ctx string) (interface{}, error) {
 	if p.Value != nil {
-		return p.Value
+		return p.Value, nil
+	}
+	if p.Function == "" {
+		return nil, nil
+	}
+
+	e.trace.add("%s", formatCall(p.Function, p.Args))
+	v, err := e.call(p, ctx)
+	if err != nil {
+		return v, &EvaluationError{Function: p.Function, Args: p.Args, Err: err}
+	}
+	e.trace.add("%v", v)
+	return v, nil
+}
+
+func (e *evaluator) call(p *Assignment, ctx string) (interface{}, error) {
+	arity := func(ok bool) error {
+		if !ok {
+			return fmt.Errorf("%w: %d arguments", ErrInvalidArguments, len(p.Args))
+		}
+		return nil
 	}
 
 	switch p.Function {
 	case ConcatFunc:
-		return p.evalConcat(std, ctx)
+		return e.evalConcat(p, ctx)
 
 	case TokenFunc:
 		// there are 3 required args
-		if len(p.Args) == 3 {
-			return p.evalToken(std, ctx)
+		if err := arity(len(p.Args) == 3); err != nil {
+			return nil, err
 		}
+		return e.evalToken(p, ctx)
 
 	case GetArtifactFunc:
-		if len(p.Args) > 1 {
-			return p.evalArtifact(std, ctx)
+		if err := arity(len(p.Args) > 1); err != nil {
+			return nil, err
 		}
+		return e.evalArtifact(p, ctx)
 
 	case GetInputFunc:
-		if len(p.Args) == 1 {
-			return std.GetInputValue(p.Args[0].(string), false)
+		if err := arity(len(p.Args) == 1); err != nil {
+			return nil, err
 		}
+		return e.evalInput(p)
 
-	case GetPropFunc:
-		return p.evalProperty(std, ctx)
+	case GetPropFunc, GetAttrFunc:
+		if err := arity(len(p.Args) > 1); err != nil {
+			return nil, err
+		}
+		return e.evalEntity(p, ctx, p.Function == GetAttrFunc)
 
-	case GetAttrFunc:
-		return p.evalAttribute(std, ctx)
+	case GetNodesOfTypeFunc:
+		if err := arity(len(p.Args) == 1); err != nil {
+			return nil, err
+		}
+		return e.std.nodesOfType(get(0, p.Args)), nil
+
+	case GetOpOutputFunc:
+		// there are 4 required args
+		if err := arity(len(p.Args) == 4); err != nil {
+			return nil, err
+		}
+		return e.evalOperationOutput(p, ctx)
 	}
 
-	return nil
+	if fn, ok := lookupFunction(p.Function); ok {
+		return fn(&FunctionContext{Template: e.std, Context: ctx, e: e}, p.Args)
+	}
+
+	return nil, fmt.Errorf("%w: %s", ErrUnknownFunction, p.Function)
 }
 
-// EvaluateForWorkflow gets the value of an Assignment, including the evaluation of expression or function
-func (p *Assignment) EvaluateForWorkflow(std *ServiceTemplateDefinition, ctx string) interface{} {
-	// TODO(kenjones): Add support for the evaluation of ConstraintClause
-	if p.Function == GetInputFunc {
-		if len(p.Args) == 1 {
-			return std.GetWorkflowInputValue(p.Args[0].(string), ctx, false)
+// EvaluateExpression tests a value against the ConstraintClause expression of the Assignment
+// and returns an error describing the failed clause when it does not match. The value is first
+// coerced to dataType, the declared type of the property, which is inferred from the value when
+// empty. std defines the data types derived from the TOSCA types, it may be nil otherwise. An
+// Assignment without an expression accepts every value.
+func (p *Assignment) EvaluateExpression(std *ServiceTemplateDefinition, dataType string, v interface{}) (bool, error) {
+	if p.Expression.Operator == "" {
+		return true, nil
+	}
+	if dataType != "" {
+		if std == nil {
+			std = &ServiceTemplateDefinition{}
+		}
+		var err error
+		if v, err = std.CoerceValue(dataType, nil, v); err != nil {
+			return false, err
 		}
+		dataType = std.primitiveType(dataType)
+	}
+	if err := p.Expression.Validate(dataType, v); err != nil {
+		return false, err
 	}
+	return true, nil
+}
+
+// Evaluate gets the value of an Assignment, including the evaluation of expression or function.
+// Failures are not reported and result in a nil value, use EvaluateE to get the error.
+func (p *Assignment) Evaluate(std *ServiceTemplateDefinition, ctx string) interface{} {
+	e := &evaluator{std: std}
+	v, _ := e.eval(p, ctx)
+	return v
+}
 
-	// If its not a get_input scenario, let Evaluate handle it.
-	return p.Evaluate(std, ctx)
+// EvaluateForWorkflow gets the value of an Assignment, including the evaluation of expression or function
+func (p *Assignment) EvaluateForWorkflow(std *ServiceTemplateDefinition, ctx string) interface{} {
+	e := &evaluator{std: std, workflow: ctx}
+	v, _ := e.eval(p, ctx)
+	return v
 }
@@ -2,6 +2,7 @@ package toscalib
 
 import (
 	"os"
+	"reflect"
 	"testing"
 )
 
@@ -678,3 +679,90 @@ func TestEvaluateWorkflowInputs(t *testing.T) {
 	}
 
 }
+
+func TestEvaluateGetOperationOutput(t *testing.T) {
+	fname := "./tests/get_operation_output.yaml"
+	var s ServiceTemplateDefinition
+	o, err := os.Open(fname)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = s.Parse(o)
+	if err != nil {
+		t.Log("Error in processing", fname)
+		t.Fatal(err)
+	}
+
+	nt := s.TopologyTemplate.NodeTemplates["app"]
+	inputs := nt.Interfaces["Standard"].Operations["configure"].Inputs
+	pa := inputs["install_dir"]
+	if v := pa.Evaluate(&s, "app"); v != nil {
+		t.Log(fname, "evaluation found value without an operation output store", v)
+		t.Fail()
+	}
+
+	s.SetOperationOutput("app", "Standard", "create", "install_dir", "/opt/app")
+	s.SetOperationOutput("server", "Standard", "create", "ip_address", "10.0.0.1")
+
+	// the store must be shared by clones of the service template
+	c := s.Clone()
+
+	for name, expected := range map[string]interface{}{
+		"install_dir": "/opt/app",
+		"host_ip":     "10.0.0.1",
+		"server_ip":   "10.0.0.1",
+		"missing":     nil,
+	} {
+		pa := inputs[name]
+		if v := pa.Evaluate(&c, "app"); v != expected {
+			t.Log(fname, "evaluation of", name, "expected", expected, "actual", v)
+			t.Fail()
+		}
+	}
+
+	req := nt.GetRequirement("host")
+	inputs = req.Relationship.Interfaces["Configure"].Operations["pre_configure_source"].Inputs
+	for name, expected := range map[string]interface{}{
+		"source_dir": "/opt/app",
+		"target_ip":  "10.0.0.1",
+	} {
+		pa := inputs[name]
+		if v := pa.Evaluate(&s, "tosca.relationships.HostedOn"); v != expected {
+			t.Log(fname, "evaluation of", name, "expected", expected, "actual", v)
+			t.Fail()
+		}
+	}
+}
+
+func TestEvaluateGetNodesOfType(t *testing.T) {
+	fname := "./tests/get_nodes_of_type.yaml"
+	var s ServiceTemplateDefinition
+	o, err := os.Open(fname)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = s.Parse(o)
+	if err != nil {
+		t.Log("Error in processing", fname)
+		t.Fatal(err)
+	}
+
+	inputs := s.TopologyTemplate.NodeTemplates["lb"].Interfaces["Standard"].Operations["configure"].Inputs
+	for name, expected := range map[string]interface{}{
+		"members":    "members=web_1,web_2",
+		"components": []string{"db", "web_1", "web_2"},
+		"none":       []string{},
+	} {
+		pa := inputs[name]
+		if v := pa.Evaluate(&s, "lb"); !reflect.DeepEqual(v, expected) {
+			t.Log(fname, "evaluation of", name, "expected", expected, "actual", v)
+			t.Fail()
+		}
+	}
+
+	step := s.TopologyTemplate.Workflows["restart-web"].Steps["restart"]
+	if targets := step.Targets(&s, "restart-web"); !reflect.DeepEqual(targets, []string{"web_1", "web_2"}) {
+		t.Log(fname, "step targets expected [web_1 web_2], actual", targets)
+		t.Fail()
+	}
+}
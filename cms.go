@@ -0,0 +1,376 @@
+package toscalib
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+)
+
+// ErrInvalidSignature is returned, wrapped, when a signature cannot be verified
+var ErrInvalidSignature = errors.New("invalid signature")
+
+// Object identifiers of the CMS (RFC 5652) structures and algorithms supported
+var (
+	oidData          = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 1}
+	oidSignedData    = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 2}
+	oidContentType   = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 3}
+	oidMessageDigest = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 4}
+
+	oidSHA256 = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 1}
+	oidSHA384 = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 2}
+	oidSHA512 = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 3}
+
+	oidRSA             = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 1, 1}
+	oidSHA256WithRSA   = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 1, 11}
+	oidSHA384WithRSA   = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 1, 12}
+	oidSHA512WithRSA   = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 1, 13}
+	oidECDSA           = asn1.ObjectIdentifier{1, 2, 840, 10045, 2, 1}
+	oidECDSAWithSHA256 = asn1.ObjectIdentifier{1, 2, 840, 10045, 4, 3, 2}
+	oidECDSAWithSHA384 = asn1.ObjectIdentifier{1, 2, 840, 10045, 4, 3, 3}
+	oidECDSAWithSHA512 = asn1.ObjectIdentifier{1, 2, 840, 10045, 4, 3, 4}
+	oidEd25519         = asn1.ObjectIdentifier{1, 3, 101, 112}
+)
+
+type contentInfo struct {
+	ContentType asn1.ObjectIdentifier
+	Content     asn1.RawValue `asn1:"explicit,optional,tag:0"`
+}
+
+type signedData struct {
+	Version          int
+	DigestAlgorithms []pkix.AlgorithmIdentifier `asn1:"set"`
+	EncapContentInfo contentInfo
+	Certificates     asn1.RawValue `asn1:"optional,tag:0"`
+	CRLs             asn1.RawValue `asn1:"optional,tag:1"`
+	SignerInfos      []signerInfo  `asn1:"set"`
+}
+
+type signerInfo struct {
+	Version            int
+	SID                asn1.RawValue // IssuerAndSerialNumber or [0] SubjectKeyIdentifier
+	DigestAlgorithm    pkix.AlgorithmIdentifier
+	SignedAttrs        asn1.RawValue `asn1:"optional,tag:0"`
+	SignatureAlgorithm pkix.AlgorithmIdentifier
+	Signature          []byte
+	UnsignedAttrs      asn1.RawValue `asn1:"optional,tag:1"`
+}
+
+type issuerAndSerial struct {
+	Issuer asn1.RawValue
+	Serial *big.Int
+}
+
+type attribute struct {
+	Type   asn1.ObjectIdentifier
+	Values asn1.RawValue // SET OF values
+}
+
+// digestHash returns the hash function of a digest algorithm
+func digestHash(oid asn1.ObjectIdentifier) (crypto.Hash, error) {
+	switch {
+	case oid.Equal(oidSHA256):
+		return crypto.SHA256, nil
+	case oid.Equal(oidSHA384):
+		return crypto.SHA384, nil
+	case oid.Equal(oidSHA512):
+		return crypto.SHA512, nil
+	}
+	return 0, fmt.Errorf("%w: unsupported digest algorithm %v", ErrInvalidSignature, oid)
+}
+
+// signatureAlgorithm returns the x509 algorithm matching a CMS signature algorithm, which may
+// designate the key type only.
+func signatureAlgorithm(oid asn1.ObjectIdentifier, h crypto.Hash) (x509.SignatureAlgorithm, error) {
+	byHash := func(algs map[crypto.Hash]x509.SignatureAlgorithm) (x509.SignatureAlgorithm, error) {
+		if alg, ok := algs[h]; ok {
+			return alg, nil
+		}
+		return x509.UnknownSignatureAlgorithm, fmt.Errorf("%w: unsupported digest %v for %v", ErrInvalidSignature, h, oid)
+	}
+	rsaAlgs := map[crypto.Hash]x509.SignatureAlgorithm{crypto.SHA256: x509.SHA256WithRSA, crypto.SHA384: x509.SHA384WithRSA, crypto.SHA512: x509.SHA512WithRSA}
+	ecdsaAlgs := map[crypto.Hash]x509.SignatureAlgorithm{crypto.SHA256: x509.ECDSAWithSHA256, crypto.SHA384: x509.ECDSAWithSHA384, crypto.SHA512: x509.ECDSAWithSHA512}
+
+	switch {
+	case oid.Equal(oidRSA):
+		return byHash(rsaAlgs)
+	case oid.Equal(oidSHA256WithRSA):
+		return x509.SHA256WithRSA, nil
+	case oid.Equal(oidSHA384WithRSA):
+		return x509.SHA384WithRSA, nil
+	case oid.Equal(oidSHA512WithRSA):
+		return x509.SHA512WithRSA, nil
+	case oid.Equal(oidECDSA):
+		return byHash(ecdsaAlgs)
+	case oid.Equal(oidECDSAWithSHA256):
+		return x509.ECDSAWithSHA256, nil
+	case oid.Equal(oidECDSAWithSHA384):
+		return x509.ECDSAWithSHA384, nil
+	case oid.Equal(oidECDSAWithSHA512):
+		return x509.ECDSAWithSHA512, nil
+	case oid.Equal(oidEd25519):
+		return x509.PureEd25519, nil
+	}
+	return x509.UnknownSignatureAlgorithm, fmt.Errorf("%w: unsupported signature algorithm %v", ErrInvalidSignature, oid)
+}
+
+// decodeCMS returns the DER encoding of a CMS signature, which may be PEM encoded
+func decodeCMS(data []byte) []byte {
+	if block, _ := pem.Decode(data); block != nil {
+		return block.Bytes
+	}
+	return data
+}
+
+// verifyCMS verifies a CMS SignedData signature of content, detached or not, and returns the
+// certificate of the signer. The certificates must chain up to roots at the time now, the
+// certificates embedded in the signature and intermediates being used as intermediates.
+func verifyCMS(signature, content []byte, roots *x509.CertPool, intermediates []*x509.Certificate, now time.Time) (*x509.Certificate, error) {
+	var ci contentInfo
+	if _, err := asn1.Unmarshal(decodeCMS(signature), &ci); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidSignature, err)
+	}
+	if !ci.ContentType.Equal(oidSignedData) {
+		return nil, fmt.Errorf("%w: not a CMS signed data", ErrInvalidSignature)
+	}
+	var sd signedData
+	// the content of a ContentInfo is explicitly tagged
+	if _, err := asn1.Unmarshal(ci.Content.Bytes, &sd); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidSignature, err)
+	}
+	if len(sd.SignerInfos) == 0 {
+		return nil, fmt.Errorf("%w: no signer", ErrInvalidSignature)
+	}
+	if content == nil && len(sd.EncapContentInfo.Content.Bytes) != 0 {
+		// the content is embedded in the signature
+		if _, err := asn1.Unmarshal(sd.EncapContentInfo.Content.Bytes, &content); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidSignature, err)
+		}
+	}
+
+	certs, err := x509.ParseCertificates(sd.Certificates.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidSignature, err)
+	}
+	certs = append(certs, intermediates...)
+
+	var signer *x509.Certificate
+	for _, si := range sd.SignerInfos {
+		cert := findSigner(si.SID, certs)
+		if cert == nil {
+			return nil, fmt.Errorf("%w: certificate of the signer not found", ErrInvalidSignature)
+		}
+		if err = si.verify(cert, content); err != nil {
+			return nil, err
+		}
+		if err = verifyChain(cert, roots, certs, now); err != nil {
+			return nil, err
+		}
+		if signer == nil {
+			signer = cert
+		}
+	}
+	return signer, nil
+}
+
+// verifyChain checks cert chains up to roots at the time now, roots must be provided so the
+// system roots are never used.
+func verifyChain(cert *x509.Certificate, roots *x509.CertPool, intermediates []*x509.Certificate, now time.Time) error {
+	if roots == nil {
+		return fmt.Errorf("%w: no trust roots", ErrInvalidSignature)
+	}
+	pool := x509.NewCertPool()
+	for _, c := range intermediates {
+		pool.AddCert(c)
+	}
+	opts := x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: pool,
+		CurrentTime:   now,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	}
+	if _, err := cert.Verify(opts); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidSignature, err)
+	}
+	return nil
+}
+
+// findSigner returns the certificate designated by a signer identifier
+func findSigner(sid asn1.RawValue, certs []*x509.Certificate) *x509.Certificate {
+	if sid.Class == asn1.ClassContextSpecific && sid.Tag == 0 {
+		for _, c := range certs {
+			if bytes.Equal(c.SubjectKeyId, sid.Bytes) {
+				return c
+			}
+		}
+		return nil
+	}
+	var ias issuerAndSerial
+	if _, err := asn1.Unmarshal(sid.FullBytes, &ias); err != nil {
+		return nil
+	}
+	for _, c := range certs {
+		if bytes.Equal(c.RawIssuer, ias.Issuer.FullBytes) && c.SerialNumber.Cmp(ias.Serial) == 0 {
+			return c
+		}
+	}
+	return nil
+}
+
+// verify checks the signature of a signer, over the signed attributes when present
+func (si signerInfo) verify(cert *x509.Certificate, content []byte) error {
+	h, err := digestHash(si.DigestAlgorithm.Algorithm)
+	if err != nil {
+		return err
+	}
+	alg, err := signatureAlgorithm(si.SignatureAlgorithm.Algorithm, h)
+	if err != nil {
+		return err
+	}
+
+	signed := content
+	if len(si.SignedAttrs.FullBytes) != 0 {
+		digest, err := si.messageDigest()
+		if err != nil {
+			return err
+		}
+		hh := h.New()
+		hh.Write(content)
+		if !bytes.Equal(hh.Sum(nil), digest) {
+			return fmt.Errorf("%w: the content does not match the message digest", ErrInvalidSignature)
+		}
+		// the signature covers the attributes encoded as a SET
+		signed = append([]byte{0x31}, si.SignedAttrs.FullBytes[1:]...)
+	}
+	if err = cert.CheckSignature(alg, signed, si.Signature); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidSignature, err)
+	}
+	return nil
+}
+
+// messageDigest returns the message digest signed attribute
+func (si signerInfo) messageDigest() ([]byte, error) {
+	for rest := si.SignedAttrs.Bytes; len(rest) != 0; {
+		var a attribute
+		var err error
+		if rest, err = asn1.Unmarshal(rest, &a); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidSignature, err)
+		}
+		if a.Type.Equal(oidMessageDigest) {
+			var digest []byte
+			if _, err = asn1.Unmarshal(a.Values.Bytes, &digest); err != nil {
+				return nil, fmt.Errorf("%w: %v", ErrInvalidSignature, err)
+			}
+			return digest, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: no message digest attribute", ErrInvalidSignature)
+}
+
+// signCMS returns a detached CMS SignedData signature of content, made by signer with the key
+// of certs[0]. The certificates are embedded in the signature.
+func signCMS(content []byte, signer crypto.Signer, certs []*x509.Certificate) ([]byte, error) {
+	if len(certs) == 0 {
+		return nil, errors.New("signing requires the certificate of the signer")
+	}
+	h, digestAlg, sigAlg := crypto.SHA256, oidSHA256, oidRSA
+	switch signer.Public().(type) {
+	case *rsa.PublicKey:
+	case *ecdsa.PublicKey:
+		sigAlg = oidECDSAWithSHA256
+	case ed25519.PublicKey:
+		h, digestAlg, sigAlg = crypto.SHA512, oidSHA512, oidEd25519
+	default:
+		return nil, fmt.Errorf("unsupported signing key %T", signer.Public())
+	}
+
+	hh := h.New()
+	hh.Write(content)
+	attrs, err := signedAttributes(hh.Sum(nil))
+	if err != nil {
+		return nil, err
+	}
+	signed := append([]byte{0x31}, attrs[1:]...)
+
+	var sig []byte
+	if _, ok := signer.Public().(ed25519.PublicKey); ok {
+		sig, err = signer.Sign(rand.Reader, signed, crypto.Hash(0))
+	} else {
+		hh = h.New()
+		hh.Write(signed)
+		sig, err = signer.Sign(rand.Reader, hh.Sum(nil), h)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	sid, err := asn1.Marshal(issuerAndSerial{Issuer: asn1.RawValue{FullBytes: certs[0].RawIssuer}, Serial: certs[0].SerialNumber})
+	if err != nil {
+		return nil, err
+	}
+	var raw []byte
+	for _, c := range certs {
+		raw = append(raw, c.Raw...)
+	}
+	sigAlgID := pkix.AlgorithmIdentifier{Algorithm: sigAlg}
+	if sigAlg.Equal(oidRSA) {
+		sigAlgID.Parameters = asn1.NullRawValue
+	}
+	sd := signedData{
+		Version:          1,
+		DigestAlgorithms: []pkix.AlgorithmIdentifier{{Algorithm: digestAlg}},
+		EncapContentInfo: contentInfo{ContentType: oidData},
+		Certificates:     asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: raw},
+		SignerInfos: []signerInfo{{
+			Version:            1,
+			SID:                asn1.RawValue{FullBytes: sid},
+			DigestAlgorithm:    pkix.AlgorithmIdentifier{Algorithm: digestAlg},
+			SignedAttrs:        asn1.RawValue{FullBytes: attrs},
+			SignatureAlgorithm: sigAlgID,
+			Signature:          sig,
+		}},
+	}
+	der, err := asn1.Marshal(sd)
+	if err != nil {
+		return nil, err
+	}
+	return asn1.Marshal(contentInfo{ContentType: oidSignedData, Content: asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: der}})
+}
+
+// signedAttributes returns the DER encoding, tagged [0], of the content type and message
+// digest attributes.
+func signedAttributes(digest []byte) ([]byte, error) {
+	ct, err := asn1.Marshal(oidData)
+	if err != nil {
+		return nil, err
+	}
+	md, err := asn1.Marshal(digest)
+	if err != nil {
+		return nil, err
+	}
+	var encoded [][]byte
+	for _, a := range []attribute{
+		{Type: oidContentType, Values: asn1.RawValue{Class: asn1.ClassUniversal, Tag: asn1.TagSet, IsCompound: true, Bytes: ct}},
+		{Type: oidMessageDigest, Values: asn1.RawValue{Class: asn1.ClassUniversal, Tag: asn1.TagSet, IsCompound: true, Bytes: md}},
+	} {
+		der, err := asn1.Marshal(a)
+		if err != nil {
+			return nil, err
+		}
+		encoded = append(encoded, der)
+	}
+	// DER sorts the elements of a SET OF
+	sort.Slice(encoded, func(i, j int) bool { return bytes.Compare(encoded[i], encoded[j]) < 0 })
+	return asn1.Marshal(asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: bytes.Join(encoded, nil)})
+}
@@ -0,0 +1,316 @@
+package toscalib
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"gopkg.in/yaml.v2"
+)
+
+// ErrTypeMismatch is returned, wrapped, when a value does not match its declared type
+var ErrTypeMismatch = errors.New("type mismatch")
+
+// primitiveTypes lists the TOSCA types which are not defined as data_types
+var primitiveTypes = map[string]bool{
+	TypeString:          true,
+	TypeInteger:         true,
+	TypeFloat:           true,
+	TypeBoolean:         true,
+	TypeTimestamp:       true,
+	TypeNull:            true,
+	TypeVersion:         true,
+	TypeRange:           true,
+	TypeList:            true,
+	TypeMap:             true,
+	TypeScalarSize:      true,
+	TypeScalarTime:      true,
+	TypeScalarFrequency: true,
+}
+
+// entrySchema is the definition of the entries of a list or a map
+type entrySchema struct {
+	Type        string      `yaml:"type"`
+	Constraints Constraints `yaml:"constraints,omitempty"`
+	EntrySchema interface{} `yaml:"entry_schema,omitempty"`
+}
+
+// toEntrySchema reads the short (type name only) or the extended notation of an entry_schema
+func toEntrySchema(v interface{}) (entrySchema, error) {
+	var es entrySchema
+	if s, ok := v.(string); ok {
+		es.Type = s
+		return es, nil
+	}
+	out, err := yaml.Marshal(v)
+	if err != nil {
+		return es, err
+	}
+	if err = yaml.Unmarshal(out, &es); err != nil {
+		return es, fmt.Errorf("invalid entry_schema %v: %v", v, err)
+	}
+	return es, nil
+}
+
+// CoerceProperty converts a value to the Go type matching a property, or parameter, definition
+// and checks it satisfies the constraints of the definition. The default is used when the value
+// is nil.
+//
+// The TOSCA types are converted to: string, int, float64, bool, time.Time (timestamp),
+// Version, Scalar (scalar-unit.*), [2]uint64 (range), []interface{} (list) and
+// map[string]interface{} (map and complex data types).
+func (s *ServiceTemplateDefinition) CoerceProperty(def PropertyDefinition, value interface{}) (interface{}, error) {
+	if value == nil {
+		value = def.Default
+	}
+	if value == nil {
+		return nil, nil
+	}
+	return s.coerce(def.Type, def.EntrySchema, def.Constraints, value)
+}
+
+// CoerceValue converts a value to the Go type matching the TOSCA type, see CoerceProperty.
+// The entry schema is required by the list and map types.
+func (s *ServiceTemplateDefinition) CoerceValue(dataType string, entrySchema interface{}, value interface{}) (interface{}, error) {
+	return s.coerce(dataType, entrySchema, nil, value)
+}
+
+func (s *ServiceTemplateDefinition) coerce(dataType string, schema interface{}, constraints Constraints, value interface{}) (interface{}, error) {
+	base := s.primitiveType(dataType)
+	v, err := s.coercePrimitive(base, dataType, schema, value)
+	if err != nil {
+		return nil, err
+	}
+
+	if dt, ok := s.dataType(dataType); ok && len(dt.Constraints) != 0 {
+		if err = dt.Constraints.Validate(base, v); err != nil {
+			return nil, err
+		}
+	}
+	if err = constraints.Validate(base, v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// dataType returns the definition of a data type including the properties and constraints
+// it inherits. The data types are flattened once by the parsing, and on demand for the
+// templates which were not parsed.
+func (s *ServiceTemplateDefinition) dataType(name string) (DataType, bool) {
+	if dt, ok := s.dataTypes[name]; ok {
+		return dt, true
+	}
+	if _, ok := s.DataTypes[name]; !ok || dataTypeCycle(name, *s) != nil {
+		return DataType{}, false
+	}
+	return flattenDataType(name, *s), true
+}
+
+// primitiveType returns the TOSCA type a data type derives from, or the data type itself when
+// it is a complex type.
+func (s *ServiceTemplateDefinition) primitiveType(dataType string) string {
+	seen := make(map[string]bool)
+	for name := dataType; !seen[name]; {
+		if primitiveTypes[name] {
+			return name
+		}
+		seen[name] = true
+		dt, ok := s.DataTypes[name]
+		if !ok || dt.DerivedFrom == "" {
+			break
+		}
+		name = dt.DerivedFrom
+	}
+	return dataType
+}
+
+func mismatch(value interface{}, dataType string, err error) error {
+	if err != nil {
+		return fmt.Errorf("%w: %v is not a valid %s: %v", ErrTypeMismatch, value, dataType, err)
+	}
+	return fmt.Errorf("%w: %v is not a valid %s", ErrTypeMismatch, value, dataType)
+}
+
+func (s *ServiceTemplateDefinition) coercePrimitive(base, dataType string, schema interface{}, value interface{}) (interface{}, error) {
+	switch base {
+	case TypeString:
+		switch v := value.(type) {
+		case string:
+			return v, nil
+		case int, int64, uint64, float64, bool:
+			return fmt.Sprint(v), nil
+		}
+		return nil, mismatch(value, dataType, nil)
+
+	case TypeInteger:
+		i, err := toInteger(value)
+		if err != nil {
+			return nil, mismatch(value, dataType, nil)
+		}
+		return int(i), nil
+
+	case TypeFloat:
+		f, err := toFloat(value)
+		if err != nil {
+			return nil, mismatch(value, dataType, nil)
+		}
+		return f, nil
+
+	case TypeBoolean:
+		b, err := toBoolean(value)
+		if err != nil {
+			return nil, mismatch(value, dataType, nil)
+		}
+		return b, nil
+
+	case TypeTimestamp:
+		t, err := toTimestamp(value)
+		if err != nil {
+			return nil, mismatch(value, dataType, nil)
+		}
+		return t, nil
+
+	case TypeNull:
+		if value != nil {
+			return nil, mismatch(value, dataType, nil)
+		}
+		return nil, nil
+
+	case TypeVersion:
+		ver, err := toVersion(value)
+		if err != nil {
+			return nil, mismatch(value, dataType, err)
+		}
+		return ver, nil
+
+	case TypeRange:
+		r, err := toRange(value)
+		if err != nil {
+			return nil, mismatch(value, dataType, err)
+		}
+		return r, nil
+
+	case TypeScalarSize, TypeScalarTime, TypeScalarFrequency:
+		sc, err := toScalar(base, value)
+		if err != nil {
+			return nil, mismatch(value, dataType, nil)
+		}
+		return sc, nil
+
+	case TypeList:
+		return s.coerceList(dataType, schema, value)
+
+	case TypeMap:
+		return s.coerceMap(dataType, schema, value)
+	}
+
+	if _, ok := s.DataTypes[base]; ok {
+		return s.coerceComplex(base, value)
+	}
+	return nil, fmt.Errorf("%w: unknown data type %s", ErrTypeMismatch, dataType)
+}
+
+func (s *ServiceTemplateDefinition) coerceEntry(schema interface{}, value interface{}) (interface{}, error) {
+	if schema == nil {
+		// without entry_schema the entries are kept as is
+		return value, nil
+	}
+	es, err := toEntrySchema(schema)
+	if err != nil {
+		return nil, err
+	}
+	return s.coerce(es.Type, es.EntrySchema, es.Constraints, value)
+}
+
+func (s *ServiceTemplateDefinition) coerceList(dataType string, schema interface{}, value interface{}) (interface{}, error) {
+	items, ok := toSlice(value)
+	if !ok {
+		return nil, mismatch(value, dataType, nil)
+	}
+	list := make([]interface{}, len(items))
+	for i, item := range items {
+		v, err := s.coerceEntry(schema, item)
+		if err != nil {
+			return nil, fmt.Errorf("[%d]: %w", i, err)
+		}
+		list[i] = v
+	}
+	return list, nil
+}
+
+// toStringMap converts a map decoded from YAML to a map with string keys
+func toStringMap(value interface{}) (map[string]interface{}, bool) {
+	switch m := value.(type) {
+	case map[string]interface{}:
+		return m, true
+	case map[interface{}]interface{}:
+		out := make(map[string]interface{}, len(m))
+		for k, v := range m {
+			out[fmt.Sprint(k)] = v
+		}
+		return out, true
+	case map[string]string:
+		out := make(map[string]interface{}, len(m))
+		for k, v := range m {
+			out[k] = v
+		}
+		return out, true
+	}
+	return nil, false
+}
+
+func (s *ServiceTemplateDefinition) coerceMap(dataType string, schema interface{}, value interface{}) (interface{}, error) {
+	m, ok := toStringMap(value)
+	if !ok {
+		return nil, mismatch(value, dataType, nil)
+	}
+	out := make(map[string]interface{}, len(m))
+	for _, k := range sortedKeys(m) {
+		v, err := s.coerceEntry(schema, m[k])
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", k, err)
+		}
+		out[k] = v
+	}
+	return out, nil
+}
+
+// coerceComplex converts a value to a complex data type, each property, inherited or not, being
+// coerced against its own definition.
+func (s *ServiceTemplateDefinition) coerceComplex(dataType string, value interface{}) (interface{}, error) {
+	m, ok := toStringMap(value)
+	if !ok {
+		return nil, mismatch(value, dataType, nil)
+	}
+	dt, _ := s.dataType(dataType)
+	props := dt.Properties
+
+	for _, k := range sortedKeys(m) {
+		if _, ok := props[k]; !ok {
+			return nil, fmt.Errorf("%w: %s is not a property of %s", ErrTypeMismatch, k, dataType)
+		}
+	}
+
+	names := make([]string, 0, len(props))
+	for name := range props {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	out := make(map[string]interface{}, len(props))
+	for _, name := range names {
+		def := props[name]
+		v, err := s.CoerceProperty(def, m[name])
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		if v == nil {
+			if def.Required {
+				return nil, fmt.Errorf("%w: required property %s of %s is missing", ErrTypeMismatch, name, dataType)
+			}
+			continue
+		}
+		out[name] = v
+	}
+	return out, nil
+}
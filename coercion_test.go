@@ -0,0 +1,242 @@
+package toscalib
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCoerceValue(t *testing.T) {
+	fname := "./tests/typed_values.yaml"
+	var s ServiceTemplateDefinition
+	o, err := os.Open(fname)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = s.Parse(o)
+	if err != nil {
+		t.Log("Error in processing", fname)
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		dataType string
+		schema   interface{}
+		value    interface{}
+		expected interface{}
+	}{
+		{TypeString, nil, "abc", "abc"},
+		{TypeString, nil, 12, "12"},
+		{TypeInteger, nil, "42", 42},
+		{TypeFloat, nil, "1.5", 1.5},
+		{TypeBoolean, nil, "true", true},
+		{TypeTimestamp, nil, "2016-04-01T10:00:00Z", time.Date(2016, 4, 1, 10, 0, 0, 0, time.UTC)},
+		{TypeRange, nil, []interface{}{1, "UNBOUNDED"}, [2]uint64{1, math.MaxInt64}},
+		{TypeList, "integer", []interface{}{"1", 2}, []interface{}{1, 2}},
+		{TypeList, nil, []interface{}{"1", 2}, []interface{}{"1", 2}},
+		{TypeMap, map[interface{}]interface{}{"type": "boolean"}, map[interface{}]interface{}{"a": "false"}, map[string]interface{}{"a": false}},
+		{"tosca.datatypes.network.PortDef", nil, "443", 443},
+		{"tosca.datatypes.network.PortSpec", nil, map[interface{}]interface{}{"target": 80}, map[string]interface{}{"protocol": "tcp", "target": 80}},
+		{"tosca.datatypes.Endpoint", nil, map[interface{}]interface{}{"host": "h", "tags": []interface{}{1}}, map[string]interface{}{"host": "h", "port": 8080, "tags": []interface{}{"1"}}},
+	}
+
+	for _, tc := range testCases {
+		v, err := s.CoerceValue(tc.dataType, tc.schema, tc.value)
+		if err != nil {
+			t.Errorf("%s %v: unexpected error %v", tc.dataType, tc.value, err)
+			continue
+		}
+		if !reflect.DeepEqual(v, tc.expected) {
+			t.Errorf("%s %v: expected %#v, actual %#v", tc.dataType, tc.value, tc.expected, v)
+		}
+	}
+
+	v, err := s.CoerceValue(TypeVersion, nil, "1.2.3")
+	if ver, ok := v.(Version); err != nil || !ok || ver.String() != "1.2.3" {
+		t.Errorf("version: expected 1.2.3, actual %v %v", v, err)
+	}
+	v, err = s.CoerceValue(TypeScalarSize, nil, "1 GB")
+	if sc, ok := v.(Scalar); err != nil || !ok || sc.BaseValue() != 1000000000 {
+		t.Errorf("scalar-unit.size: expected 1 GB, actual %v %v", v, err)
+	}
+
+	invalids := []struct {
+		dataType   string
+		schema     interface{}
+		value      interface{}
+		constraint bool
+	}{
+		{TypeInteger, nil, "abc", false},
+		{TypeBoolean, nil, "maybe", false},
+		{TypeString, nil, []interface{}{"a"}, false},
+		{TypeList, "integer", []interface{}{"a"}, false},
+		{TypeMap, "string", "a", false},
+		{"tosca.datatypes.network.PortDef", nil, 70000, true},
+		{"tosca.datatypes.network.PortSpec", nil, map[interface{}]interface{}{"protocol": "http"}, true},
+		{"tosca.datatypes.network.PortSpec", nil, map[interface{}]interface{}{"unknown": 1}, false},
+		{"tosca.datatypes.Endpoint", nil, map[interface{}]interface{}{"port": 80}, false},
+		{"tosca.datatypes.Unknown", nil, "a", false},
+	}
+
+	for _, tc := range invalids {
+		_, err := s.CoerceValue(tc.dataType, tc.schema, tc.value)
+		var ce *ConstraintError
+		if tc.constraint && !errors.As(err, &ce) {
+			t.Errorf("%s %v: expected a constraint error, actual %v", tc.dataType, tc.value, err)
+		}
+		if !tc.constraint && !errors.Is(err, ErrTypeMismatch) {
+			t.Errorf("%s %v: expected a type mismatch, actual %v", tc.dataType, tc.value, err)
+		}
+	}
+}
+
+func TestPropertyValue(t *testing.T) {
+	fname := "./tests/typed_values.yaml"
+	var s ServiceTemplateDefinition
+	o, err := os.Open(fname)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = s.Parse(o)
+	if err != nil {
+		t.Log("Error in processing", fname)
+		t.Fatal(err)
+	}
+
+	if diags := s.Validate(); len(diags) != 0 {
+		t.Errorf("%s: expected no diagnostics, actual %v", fname, diags)
+	}
+
+	testCases := []struct {
+		prop     string
+		expected interface{}
+	}{
+		{"replicas", 3},
+		{"ratio", 0.5},
+		{"enabled", false},
+		{"started", time.Date(2016, 4, 1, 10, 0, 0, 0, time.UTC)},
+		{"ports", [2]uint64{8000, math.MaxInt64}},
+		{"weights", map[string]interface{}{"a": 1, "b": 2}},
+		{"endpoint", map[string]interface{}{"host": "example.com", "port": 8080, "tags": []interface{}{"web"}}},
+		{"timeout", nil},
+	}
+
+	for _, tc := range testCases {
+		v, err := s.PropertyValue("app", tc.prop)
+		if err != nil || !reflect.DeepEqual(v, tc.expected) {
+			t.Errorf("app.%s: expected %#v, actual %#v %v", tc.prop, tc.expected, v, err)
+		}
+	}
+
+	if v, err := s.InputValue("names"); err != nil || !reflect.DeepEqual(v, []interface{}{"a", "b"}) {
+		t.Errorf("input names: expected [a b], actual %#v %v", v, err)
+	}
+	if _, err := s.PropertyValue("app", "none"); !errors.Is(err, ErrPropertyNotFound) {
+		t.Errorf("app.none: expected %v, actual %v", ErrPropertyNotFound, err)
+	}
+
+	// the value of the input is out of the range of replicas
+	s.TopologyTemplate.Inputs["replicas"] = PropertyDefinition{Type: TypeInteger, Value: PropertyAssignment{Assignment{Value: 12}}}
+	var ce *ConstraintError
+	if _, err := s.PropertyValue("app", "replicas"); !errors.As(err, &ce) {
+		t.Errorf("app.replicas: expected a constraint error, actual %v", err)
+	}
+	s.TopologyTemplate.NodeTemplates["app"].Properties["replicas"] = PropertyAssignment{Assignment{Value: "0"}}
+	diags := s.Validate()
+	if len(diags) != 1 || diags[0].Code != CodeConstraintViolation {
+		t.Errorf("%s: expected a constraint violation, actual %v", fname, diags)
+	}
+}
+
+func TestDataTypeInheritance(t *testing.T) {
+	fname := "./tests/data_type_inheritance.yaml"
+	var s ServiceTemplateDefinition
+	o, err := os.Open(fname)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = s.Parse(o)
+	if err != nil {
+		t.Log("Error in processing", fname)
+		t.Fatal(err)
+	}
+
+	ft := flattenHierarchy(s, flatTypes{})
+	if len(s.dataTypes) != len(ft.DataTypes) {
+		t.Errorf("%s: expected the flattened data types kept, actual %d of %d", fname, len(s.dataTypes), len(ft.DataTypes))
+	}
+	dt := ft.DataTypes["tosca.datatypes.StrictLimits"]
+	if len(dt.Properties) != 3 {
+		t.Errorf("StrictLimits: expected 3 properties, actual %v", dt.Properties)
+	}
+	if mem := dt.Properties["memory"]; mem.Required || len(mem.Constraints) != 2 {
+		t.Errorf("StrictLimits: expected an optional memory with 2 constraints, actual %+v", mem)
+	}
+	if hp := ft.DataTypes["tosca.datatypes.HighPercent"]; len(hp.Constraints) != 2 {
+		t.Errorf("HighPercent: expected 2 constraints, actual %v", hp.Constraints)
+	}
+	// the normative types are flattened as well
+	if c := ft.DataTypes["tosca.datatypes.Credential"]; c.DerivedFrom != "tosca.datatypes.Root" || len(c.Properties) != 5 {
+		t.Errorf("Credential: unexpected flattened type %+v", c)
+	}
+
+	if diags := s.Validate(); len(diags) != 0 {
+		t.Errorf("%s: expected no diagnostics, actual %v", fname, diags)
+	}
+	v, err := s.PropertyValue("app", "limits")
+	m, ok := v.(map[string]interface{})
+	if err != nil || !ok || m["cpu"] != 80 || m["swap"] != false || fmt.Sprint(m["memory"]) != "128 MB" {
+		t.Errorf("app.limits: unexpected value %#v %v", v, err)
+	}
+
+	invalids := []struct {
+		dataType string
+		value    interface{}
+	}{
+		// constraint inherited from Percent
+		{"tosca.datatypes.HighPercent", 120},
+		// constraint of HighPercent
+		{"tosca.datatypes.HighPercent", 20},
+		// constraint of the refined property and of the parent property
+		{"tosca.datatypes.StrictLimits", map[interface{}]interface{}{"memory": "2 GB"}},
+		{"tosca.datatypes.StrictLimits", map[interface{}]interface{}{"memory": "32 MB"}},
+		// type of the refined property
+		{"tosca.datatypes.StrictLimits", map[interface{}]interface{}{"cpu": 20}},
+	}
+
+	for _, tc := range invalids {
+		var ce *ConstraintError
+		if _, err := s.CoerceValue(tc.dataType, nil, tc.value); !errors.As(err, &ce) {
+			t.Errorf("%s %v: expected a constraint error, actual %v", tc.dataType, tc.value, err)
+		}
+	}
+
+	// a derivation cycle is rejected instead of flattened
+	data := `tosca_definitions_version: tosca_simple_yaml_1_0
+data_types:
+  org.example.datatypes.Size:
+    derived_from: org.example.datatypes.Small
+  org.example.datatypes.Small:
+    derived_from: org.example.datatypes.Tiny
+  org.example.datatypes.Tiny:
+    derived_from: org.example.datatypes.Small
+`
+	var c ServiceTemplateDefinition
+	err = c.ParseReader(strings.NewReader(data), defaultResolver, ParserHooks{ParsedSTD: noop})
+	pe, ok := err.(*ParseError)
+	if !ok || !errors.Is(err, ErrDerivationCycle) || pe.Path != "data_types.org.example.datatypes.Small.derived_from" || pe.Location.Line != 6 {
+		t.Fatalf("ParseReader: expected ErrDerivationCycle at data_types.org.example.datatypes.Small.derived_from, actual %T %v", err, err)
+	}
+	c = ServiceTemplateDefinition{DataTypes: map[string]DataType{
+		"org.example.datatypes.Size":  {DerivedFrom: "org.example.datatypes.Small"},
+		"org.example.datatypes.Small": {DerivedFrom: "org.example.datatypes.Size"},
+	}}
+	if _, err = c.CoerceValue("org.example.datatypes.Size", nil, map[interface{}]interface{}{"a": 1}); !errors.Is(err, ErrTypeMismatch) {
+		t.Errorf("CoerceValue: expected ErrTypeMismatch for a data type deriving from itself, actual %v", err)
+	}
+}
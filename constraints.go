@@ -19,6 +19,13 @@ package toscalib
 import (
 	"errors"
 	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
 )
 
 // Operators is a list of supported constraint operators
@@ -50,9 +57,24 @@ type Constraints []ConstraintClause
 
 // IsValid returns true if the value is valid against the Constraints
 func (c *Constraints) IsValid(v interface{}) (bool, error) {
+	if err := c.Validate("", v); err != nil {
+		return false, err
+	}
 	return true, nil
 }
 
+// Validate checks the value, interpreted as the named TOSCA type, against every
+// ConstraintClause and returns the error of the first clause that is not satisfied.
+// When dataType is empty the type is inferred from the value.
+func (c *Constraints) Validate(dataType string, v interface{}) error {
+	for i := range *c {
+		if err := (*c)[i].Validate(dataType, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // ConstraintClause definition as described in Appendix 5.2.
 // This is a map where the index is a string that may have a value in
 // {"equal","greater_than", ...} (see Appendix 5.2) a,s value is an interface
@@ -63,8 +85,133 @@ type ConstraintClause struct {
 	Values   interface{}
 }
 
+// ConstraintError describes a value that does not satisfy a ConstraintClause
+type ConstraintError struct {
+	Clause ConstraintClause
+	Value  interface{}
+	Reason string
+}
+
+func (e *ConstraintError) Error() string {
+	return fmt.Sprintf("constraint %s: %v failed for value %v: %s", e.Clause.Operator, e.Clause.Values, e.Value, e.Reason)
+}
+
 // Evaluate the constraint and return a boolean
-func (constraint *ConstraintClause) Evaluate(interface{}) bool { return true }
+func (constraint *ConstraintClause) Evaluate(v interface{}) bool {
+	return constraint.Validate("", v) == nil
+}
+
+// Validate checks the value, interpreted as the named TOSCA type, against the constraint.
+// When dataType is empty the type is inferred from the value. A *ConstraintError is
+// returned when the value does not satisfy the constraint.
+func (constraint *ConstraintClause) Validate(dataType string, v interface{}) error {
+	fail := func(format string, a ...interface{}) error {
+		return &ConstraintError{Clause: *constraint, Value: v, Reason: fmt.Sprintf(format, a...)}
+	}
+
+	if dataType == "" {
+		dataType = inferType(v)
+	}
+
+	switch constraint.Operator {
+	case "equal":
+		cmp, err := compareValues(dataType, v, constraint.Values)
+		if err != nil {
+			return fail("%v", err)
+		}
+		if cmp != 0 {
+			return fail("value is not equal to %v", constraint.Values)
+		}
+
+	case "greater_than", "greater_or_equal", "less_than", "less_or_equal":
+		if !isOrdered(dataType) {
+			return fail("values of type %s cannot be ordered", dataType)
+		}
+		cmp, err := compareValues(dataType, v, constraint.Values)
+		if err != nil {
+			return fail("%v", err)
+		}
+		if !checkOrder(constraint.Operator, cmp) {
+			return fail("value is not %s %v", strings.Replace(constraint.Operator, "_", " ", -1), constraint.Values)
+		}
+
+	case "in_range":
+		bounds, ok := toSlice(constraint.Values)
+		if !ok || len(bounds) != 2 {
+			return fail("in_range requires a list of two values")
+		}
+		if dataType == TypeRange {
+			r, err := toRange(v)
+			if err != nil {
+				return fail("%v", err)
+			}
+			if err = checkRange(r[0], bounds); err != nil {
+				return fail("lower bound %v", err)
+			}
+			if err = checkRange(r[1], bounds); err != nil {
+				return fail("upper bound %v", err)
+			}
+			return nil
+		}
+		if !isOrdered(dataType) {
+			return fail("values of type %s cannot be ordered", dataType)
+		}
+		if err := checkInRange(dataType, v, bounds); err != nil {
+			return fail("value %v", err)
+		}
+
+	case "valid_values":
+		values, ok := toSlice(constraint.Values)
+		if !ok {
+			return fail("valid_values requires a list of values")
+		}
+		for _, val := range values {
+			if cmp, err := compareValues(dataType, v, val); err == nil && cmp == 0 {
+				return nil
+			}
+		}
+		return fail("value is not one of %v", constraint.Values)
+
+	case "length", "min_length", "max_length":
+		l, err := valueLength(v)
+		if err != nil {
+			return fail("%v", err)
+		}
+		expected, err := toInteger(constraint.Values)
+		if err != nil {
+			return fail("%s requires an integer: %v", constraint.Operator, err)
+		}
+		switch {
+		case constraint.Operator == "length" && int64(l) != expected:
+			return fail("length %d is not %d", l, expected)
+		case constraint.Operator == "min_length" && int64(l) < expected:
+			return fail("length %d is less than %d", l, expected)
+		case constraint.Operator == "max_length" && int64(l) > expected:
+			return fail("length %d is greater than %d", l, expected)
+		}
+
+	case "pattern":
+		pattern, ok := constraint.Values.(string)
+		if !ok {
+			return fail("pattern requires a string")
+		}
+		re, err := regexp.Compile("^(?:" + pattern + ")$")
+		if err != nil {
+			return fail("invalid pattern: %v", err)
+		}
+		s, ok := v.(string)
+		if !ok {
+			return fail("pattern only applies to strings")
+		}
+		if !re.MatchString(s) {
+			return fail("value does not match the pattern")
+		}
+
+	default:
+		return fail("unknown operator")
+	}
+	return nil
+}
 
 // UnmarshalYAML handles simple and complex format when converting from YAML to types
 func (constraint *ConstraintClause) UnmarshalYAML(unmarshal func(interface{}) error) error {
@@ -90,3 +237,373 @@ func (constraint *ConstraintClause) UnmarshalYAML(unmarshal func(interface{}) er
 	constraint.Values = v
 	return nil
 }
+
+func checkOrder(op string, cmp int) bool {
+	switch op {
+	case "greater_than":
+		return cmp > 0
+	case "greater_or_equal":
+		return cmp >= 0
+	case "less_than":
+		return cmp < 0
+	case "less_or_equal":
+		return cmp <= 0
+	}
+	return false
+}
+
+// checkInRange verifies that lower <= v <= upper, the upper bound may be UNBOUNDED.
+func checkInRange(dataType string, v interface{}, bounds []interface{}) error {
+	cmp, err := compareValues(dataType, v, bounds[0])
+	if err != nil {
+		return err
+	}
+	if cmp < 0 {
+		return fmt.Errorf("is less than lower bound %v", bounds[0])
+	}
+	if isUnbounded(bounds[1]) {
+		return nil
+	}
+	cmp, err = compareValues(dataType, v, bounds[1])
+	if err != nil {
+		return err
+	}
+	if cmp > 0 {
+		return fmt.Errorf("is greater than upper bound %v", bounds[1])
+	}
+	return nil
+}
+
+func checkRange(v uint64, bounds []interface{}) error {
+	if v == UNBOUNDED {
+		if isUnbounded(bounds[1]) {
+			return nil
+		}
+		return fmt.Errorf("UNBOUNDED is greater than upper bound %v", bounds[1])
+	}
+	return checkInRange(TypeInteger, int64(v), bounds)
+}
+
+func isUnbounded(v interface{}) bool {
+	s, ok := v.(string)
+	return ok && s == "UNBOUNDED"
+}
+
+// inferType guesses the TOSCA type of a value that has no declared type.
+func inferType(v interface{}) string {
+	switch val := v.(type) {
+	case bool:
+		return TypeBoolean
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return TypeInteger
+	case float32, float64:
+		return TypeFloat
+	case time.Time:
+		return TypeTimestamp
+	case Scalar:
+		return val.Kind()
+	case Version:
+		return TypeVersion
+	case string:
+		if _, err := strconv.ParseFloat(val, 64); err == nil {
+			return TypeFloat
+		}
+		if s, err := parseScalar(val); err == nil {
+			return s.Kind()
+		}
+		return TypeString
+	}
+
+	switch reflect.ValueOf(v).Kind() {
+	case reflect.Slice, reflect.Array:
+		return TypeList
+	case reflect.Map:
+		return TypeMap
+	}
+	return TypeString
+}
+
+// compareValues converts both values to the TOSCA type and returns -1, 0 or 1
+// when a is respectively less than, equal to or greater than b.
+func compareValues(dataType string, a, b interface{}) (int, error) {
+	switch dataType {
+	case TypeInteger:
+		x, err := toInteger(a)
+		if err != nil {
+			return 0, err
+		}
+		y, err := toInteger(b)
+		if err != nil {
+			return 0, err
+		}
+		return compareFloats(float64(x), float64(y)), nil
+
+	case TypeFloat:
+		x, err := toFloat(a)
+		if err != nil {
+			return 0, err
+		}
+		y, err := toFloat(b)
+		if err != nil {
+			return 0, err
+		}
+		return compareFloats(x, y), nil
+
+	case TypeBoolean:
+		x, err := toBoolean(a)
+		if err != nil {
+			return 0, err
+		}
+		y, err := toBoolean(b)
+		if err != nil {
+			return 0, err
+		}
+		if x == y {
+			return 0, nil
+		}
+		return 1, nil
+
+	case TypeTimestamp:
+		x, err := toTimestamp(a)
+		if err != nil {
+			return 0, err
+		}
+		y, err := toTimestamp(b)
+		if err != nil {
+			return 0, err
+		}
+		switch {
+		case x.Before(y):
+			return -1, nil
+		case x.After(y):
+			return 1, nil
+		}
+		return 0, nil
+
+	case TypeVersion:
+		x, err := toVersion(a)
+		if err != nil {
+			return 0, err
+		}
+		y, err := toVersion(b)
+		if err != nil {
+			return 0, err
+		}
+		return x.Compare(y.Version), nil
+
+	case TypeScalarSize, TypeScalarTime, TypeScalarFrequency:
+		x, err := toScalar(dataType, a)
+		if err != nil {
+			return 0, err
+		}
+		y, err := toScalar(dataType, b)
+		if err != nil {
+			return 0, err
+		}
+		return compareFloats(x.BaseValue(), y.BaseValue()), nil
+
+	case TypeString:
+		return strings.Compare(fmt.Sprint(a), fmt.Sprint(b)), nil
+	}
+
+	// list, map, range and complex data types can only be compared for equality
+	if reflect.DeepEqual(a, b) {
+		return 0, nil
+	}
+	return 1, nil
+}
+
+// isOrdered returns true if values of the TOSCA type support the comparison operators
+func isOrdered(dataType string) bool {
+	switch dataType {
+	case TypeInteger, TypeFloat, TypeTimestamp, TypeVersion, TypeString,
+		TypeScalarSize, TypeScalarTime, TypeScalarFrequency:
+		return true
+	}
+	return false
+}
+
+func compareFloats(x, y float64) int {
+	switch {
+	case x < y:
+		return -1
+	case x > y:
+		return 1
+	}
+	return 0
+}
+
+func toInteger(v interface{}) (int64, error) {
+	switch val := v.(type) {
+	case int:
+		return int64(val), nil
+	case int8:
+		return int64(val), nil
+	case int16:
+		return int64(val), nil
+	case int32:
+		return int64(val), nil
+	case int64:
+		return val, nil
+	case uint:
+		if uint64(val) <= math.MaxInt64 {
+			return int64(val), nil
+		}
+	case uint8:
+		return int64(val), nil
+	case uint16:
+		return int64(val), nil
+	case uint32:
+		return int64(val), nil
+	case uint64:
+		if val <= math.MaxInt64 {
+			return int64(val), nil
+		}
+	case float32:
+		if float32(int64(val)) == val {
+			return int64(val), nil
+		}
+	case float64:
+		if float64(int64(val)) == val {
+			return int64(val), nil
+		}
+	case string:
+		return strconv.ParseInt(strings.TrimSpace(val), 10, 64)
+	}
+	return 0, fmt.Errorf("%v is not an integer", v)
+}
+
+func toFloat(v interface{}) (float64, error) {
+	switch val := v.(type) {
+	case float32:
+		return float64(val), nil
+	case float64:
+		return val, nil
+	case string:
+		return strconv.ParseFloat(strings.TrimSpace(val), 64)
+	}
+	i, err := toInteger(v)
+	if err != nil {
+		return 0, fmt.Errorf("%v is not a float", v)
+	}
+	return float64(i), nil
+}
+
+func toBoolean(v interface{}) (bool, error) {
+	switch val := v.(type) {
+	case bool:
+		return val, nil
+	case string:
+		switch strings.ToLower(val) {
+		case "true":
+			return true, nil
+		case "false":
+			return false, nil
+		}
+	}
+	return false, fmt.Errorf("%v is not a boolean", v)
+}
+
+// timestampLayouts are the YAML 1.1 timestamp formats accepted for the timestamp type
+var timestampLayouts = []string{
+	time.RFC3339Nano,
+	"2006-1-2t15:4:5.999999999Z07:00",
+	"2006-1-2 15:4:5.999999999 -07:00",
+	"2006-1-2 15:4:5.999999999Z07:00",
+	"2006-1-2 15:4:5.999999999",
+	"2006-1-2",
+}
+
+func toTimestamp(v interface{}) (time.Time, error) {
+	switch val := v.(type) {
+	case time.Time:
+		return val, nil
+	case string:
+		for _, layout := range timestampLayouts {
+			if t, err := time.Parse(layout, strings.TrimSpace(val)); err == nil {
+				return t, nil
+			}
+		}
+	}
+	return time.Time{}, fmt.Errorf("%v is not a timestamp", v)
+}
+
+func toVersion(v interface{}) (Version, error) {
+	switch val := v.(type) {
+	case Version:
+		return val, nil
+	case string:
+		return parseVersion(val)
+	case int, int64, uint64, float64:
+		return parseVersion(fmt.Sprint(val))
+	}
+	return Version{}, fmt.Errorf("%v is not a version", v)
+}
+
+func toScalar(dataType string, v interface{}) (Scalar, error) {
+	var s Scalar
+	switch val := v.(type) {
+	case Scalar:
+		s = val
+	case string:
+		var err error
+		if s, err = parseScalar(val); err != nil {
+			return s, fmt.Errorf("%v is not a %s: %v", v, dataType, err)
+		}
+	default:
+		return s, fmt.Errorf("%v is not a %s", v, dataType)
+	}
+	if s.Kind() != dataType {
+		return s, fmt.Errorf("%v is not a %s", v, dataType)
+	}
+	return s, nil
+}
+
+// toRange converts a [lower, upper] value to its boundaries where the
+// upper boundary may be UNBOUNDED.
+func toRange(v interface{}) ([2]uint64, error) {
+	var r [2]uint64
+	items, ok := toSlice(v)
+	if !ok || len(items) != 2 {
+		return r, fmt.Errorf("%v is not a range", v)
+	}
+	for i, item := range items {
+		if i == 1 && isUnbounded(item) {
+			r[i] = UNBOUNDED
+			continue
+		}
+		n, err := toInteger(item)
+		if err != nil || n < 0 {
+			return r, fmt.Errorf("%v is not a range", v)
+		}
+		r[i] = uint64(n)
+	}
+	if r[0] > r[1] {
+		return r, errors.New("range lower bound is greater than upper bound")
+	}
+	return r, nil
+}
+
+func toSlice(v interface{}) ([]interface{}, bool) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return nil, false
+	}
+	items := make([]interface{}, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		items[i] = rv.Index(i).Interface()
+	}
+	return items, true
+}
+
+func valueLength(v interface{}) (int, error) {
+	if s, ok := v.(string); ok {
+		return utf8.RuneCountInString(s), nil
+	}
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return rv.Len(), nil
+	}
+	return 0, fmt.Errorf("length does not apply to %v", v)
+}
@@ -0,0 +1,148 @@
+package toscalib
+
+import (
+	"math"
+	"os"
+	"testing"
+
+	"gopkg.in/yaml.v2"
+)
+
+func TestConstraintClauseValidate(t *testing.T) {
+	type constraintCase struct {
+		clause   string
+		dataType string
+		value    interface{}
+		expected bool
+	}
+	testCases := []constraintCase{
+		{"equal: 2", TypeInteger, 2, true},
+		{"equal: 2", TypeInteger, "3", false},
+		{"equal: PUBLIC", TypeString, "PUBLIC", true},
+		{"equal: true", TypeBoolean, "true", true},
+		{"equal: true", TypeBoolean, false, false},
+		{"greater_than: 1", TypeInteger, 2, true},
+		{"greater_than: 1", TypeInteger, 1, false},
+		{"greater_or_equal: 1", TypeInteger, 1, true},
+		{"less_than: 2.5", TypeFloat, 2.4, true},
+		{"less_or_equal: 2.5", TypeFloat, 2.6, false},
+		{"greater_or_equal: 1 MB", TypeScalarSize, "1 GB", true},
+		{"greater_or_equal: 1 MB", TypeScalarSize, "1000 B", false},
+		{"greater_or_equal: 1 MB", TypeScalarSize, "1 GHz", false},
+		{"greater_or_equal: 0.1 GHz", TypeScalarFrequency, "500 mhz", true},
+		{"less_than: 1 h", TypeScalarTime, "59 m", true},
+		{"greater_than: 1.2", TypeVersion, "1.10", true},
+		{"greater_or_equal: 2016-01-01", TypeTimestamp, "2015-12-31T23:00:00Z", false},
+		{"equal: 10", TypeInteger, "010", true},
+		{"equal: 31", TypeInteger, "0x1F", false},
+		{"greater_than: 0", TypeInteger, uint64(math.MaxUint64), false},
+		{"greater_than: 0", TypeInteger, uint64(math.MaxInt64), true},
+		{"in_range: [ 1, 65535 ]", TypeInteger, 8080, true},
+		{"in_range: [ 1, 65535 ]", TypeInteger, 0, false},
+		{"in_range: [ 1, 65535 ]", TypeInteger, 70000, false},
+		{"in_range: [ 1, UNBOUNDED ]", TypeInteger, 70000, true},
+		{"in_range: [ 1, 65535 ]", TypeRange, []interface{}{80, 90}, true},
+		{"in_range: [ 1, 65535 ]", TypeRange, []interface{}{80, "UNBOUNDED"}, false},
+		{"valid_values: [ 1, 2, 4, 8 ]", TypeInteger, 4, true},
+		{"valid_values: [ 1, 2, 4, 8 ]", TypeInteger, 3, false},
+		{"valid_values: [ udp, tcp, igmp ]", TypeString, "tcp", true},
+		{"length: 3", TypeString, "abc", true},
+		{"length: 3", TypeList, []interface{}{1, 2}, false},
+		{"min_length: 1", TypeMap, map[interface{}]interface{}{"a": 1}, true},
+		{"max_length: 2", TypeString, "abc", false},
+		{"pattern: '[a-z]+'", TypeString, "abc", true},
+		{"pattern: '[a-z]+'", TypeString, "abc1", false},
+		{"greater_than: true", TypeBoolean, true, false},
+		{"equal: 5", "", 5, true},
+		{"greater_or_equal: 5.5", "", "5.6", true},
+		{"greater_or_equal: 1 MB", "", "512 kB", false},
+	}
+
+	for _, tc := range testCases {
+		var c ConstraintClause
+		if err := yaml.Unmarshal([]byte(tc.clause), &c); err != nil {
+			t.Fatal(err)
+		}
+		err := c.Validate(tc.dataType, tc.value)
+		if (err == nil) != tc.expected {
+			t.Errorf("constraint %q with %s value %v, expected %v, actual %v", tc.clause, tc.dataType, tc.value, tc.expected, err)
+		}
+		if err != nil {
+			if _, ok := err.(*ConstraintError); !ok {
+				t.Errorf("constraint %q returned an error of unexpected type %T", tc.clause, err)
+			}
+		}
+		if c.Evaluate(tc.value) != (c.Validate("", tc.value) == nil) {
+			t.Errorf("constraint %q Evaluate does not match Validate for value %v", tc.clause, tc.value)
+		}
+	}
+}
+
+func TestConstraintsIsValid(t *testing.T) {
+	var c Constraints
+	if err := yaml.Unmarshal([]byte("[ {min_length: 2}, {max_length: 4} ]"), &c); err != nil {
+		t.Fatal(err)
+	}
+
+	if ok, err := c.IsValid("abc"); !ok || err != nil {
+		t.Errorf("constraints %v, expected `abc` to be valid, actual %v", c, err)
+	}
+
+	ok, err := c.IsValid("abcde")
+	if ok || err == nil {
+		t.Errorf("constraints %v, expected `abcde` to be invalid", c)
+	} else if ce, ok := err.(*ConstraintError); !ok || ce.Clause.Operator != "max_length" {
+		t.Errorf("constraints %v, expected max_length to fail, actual %v", c, err)
+	}
+}
+
+func TestEvaluateExpression(t *testing.T) {
+	fname := "./tests/tosca_abstract_db_node_template.yaml"
+	var s ServiceTemplateDefinition
+	o, err := os.Open(fname)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = s.Parse(o)
+	if err != nil {
+		t.Log("Error in processing", fname)
+		t.Fatal(err)
+	}
+
+	prop := s.TopologyTemplate.NodeTemplates["my_abstract_database"].Properties["db_version"]
+	if ok, err := prop.EvaluateExpression(&s, "", "5.7"); !ok {
+		t.Log(fname, "expression failed for a valid `db_version`", err)
+		t.Fail()
+	}
+	if ok, _ := prop.EvaluateExpression(&s, "", "5.1"); ok {
+		t.Log(fname, "expression succeeded for an invalid `db_version`")
+		t.Fail()
+	}
+
+	// the value is compared as the declared type, not as the type inferred from it
+	testCases := []struct {
+		dataType string
+		value    interface{}
+		expected bool
+	}{
+		{"", "5.10", false},
+		{TypeVersion, "5.10", true},
+		{TypeVersion, "5.4.2", false},
+		{TypeFloat, "5.10", false},
+		{TypeFloat, "6", true},
+	}
+	for _, tc := range testCases {
+		if ok, err := prop.EvaluateExpression(nil, tc.dataType, tc.value); ok != tc.expected {
+			t.Errorf("%s: expected %v for %v as %q, actual %v %v", fname, tc.expected, tc.value, tc.dataType, ok, err)
+		}
+	}
+	if ok, err := prop.EvaluateExpression(nil, TypeInteger, "five"); ok || err == nil {
+		t.Errorf("%s: expected a value not coercible to the declared type rejected, actual %v", fname, ok)
+	}
+
+	prop = s.TopologyTemplate.NodeTemplates["my_app"].Properties["admin_user"]
+	if ok, err := prop.EvaluateExpression(&s, TypeString, "anything"); !ok {
+		t.Log(fname, "property without an expression should accept any value", err)
+		t.Fail()
+	}
+}
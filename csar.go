@@ -0,0 +1,357 @@
+package toscalib
+
+import (
+	"archive/zip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// csarMetaFile is the location of the metadata file within a CSAR
+const csarMetaFile = "TOSCA-Metadata/TOSCA.meta"
+
+// ErrOutsideArchive is returned when a CSAR imports a remote document and no resolver is
+// provided for such imports.
+var ErrOutsideArchive = errors.New("import outside the archive")
+
+// ErrNoEntryDefinitions is returned, wrapped, when the main document of a CSAR cannot be found
+var ErrNoEntryDefinitions = errors.New("no entry definitions")
+
+// CsarMeta holds the fields of the TOSCA.meta file of a CSAR
+type CsarMeta struct {
+	MetaFileVersion  string            // TOSCA-Meta-File-Version
+	CsarVersion      string            // CSAR-Version
+	CreatedBy        string            // Created-By
+	EntryDefinitions string            // Entry-Definitions, the path of the main document in the archive.
+	EntryManifest    string            // ETSI-Entry-Manifest, the path of the manifest listing the digests of the files.
+	EntryCertificate string            // ETSI-Entry-Certificate, the path of the certificates of the signer of the manifest.
+	Extra            map[string]string // The other keys, for example Content-Type.
+	Entries          []CsarEntry       // The blocks describing the files of the archive.
+}
+
+// CsarEntry is a block of TOSCA.meta describing a file of the archive, such as an artifact
+type CsarEntry struct {
+	Name        string            // Name, or Source, the path of the file in the archive.
+	ContentType string            // Content-Type
+	Algorithm   string            // Algorithm of the Hash, SHA-256 or SHA-512.
+	Hash        string            // Hash, the hexadecimal digest of the file.
+	Extra       map[string]string // The other keys.
+}
+
+// CsarLimits bounds the content of an archive, so a zip bomb is rejected before being read.
+// The zero fields use the values of DefaultCsarLimits.
+type CsarLimits struct {
+	MaxFiles    int   // The number of entries of the archive.
+	MaxFileSize int64 // The uncompressed size of an entry.
+	MaxSize     int64 // The uncompressed size of all the entries.
+}
+
+// DefaultCsarLimits are the limits applied to the archives unless set otherwise
+var DefaultCsarLimits = CsarLimits{MaxFiles: 10000, MaxFileSize: 100 << 20, MaxSize: 1 << 30}
+
+// CsarOptions configures the parsing of a CSAR
+type CsarOptions struct {
+	// Hooks are called while parsing the documents of the archive, ParsedSTD may be nil.
+	Hooks ParserHooks
+	// Resolver retrieves the imports located outside the archive, such as http URLs. They are
+	// rejected when nil.
+	Resolver ContextResolver
+	// Name designates the archive in the source locations, ParseCsarFile defaults to the file name.
+	Name string
+	// Verify checks the digests and the signature of the archive before parsing it, see VerifyCsar.
+	Verify *CsarVerifyOptions
+	// Limits bounds the content of the archive, DefaultCsarLimits by default.
+	Limits CsarLimits
+}
+
+// ParseCsar handles open and parse the CSAR file
+func (t *ServiceTemplateDefinition) ParseCsar(zipfile string) error {
+	return t.ParseCsarContext(context.Background(), zipfile)
+}
+
+// ParseCsarContext opens and parses the CSAR file, the parsing stops when ctx is done
+func (t *ServiceTemplateDefinition) ParseCsarContext(ctx context.Context, zipfile string) error {
+	_, err := t.ParseCsarFile(ctx, zipfile, CsarOptions{})
+	return err
+}
+
+// ParseCsarFile opens and parses the CSAR file according to opts and returns its metadata
+func (t *ServiceTemplateDefinition) ParseCsarFile(ctx context.Context, zipfile string, opts CsarOptions) (*CsarMeta, error) {
+	f, err := os.Open(zipfile)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	fi, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	if opts.Name == "" {
+		opts.Name = zipfile
+	}
+	return t.ParseCsarReader(ctx, f, fi.Size(), opts)
+}
+
+// ParseCsarReader parses the CSAR of size bytes read from r according to opts and returns its
+// metadata, so an archive received over the network can be parsed without a temporary file.
+// The metadata is returned as well when the parsing of the documents fails.
+func (t *ServiceTemplateDefinition) ParseCsarReader(ctx context.Context, r io.ReaderAt, size int64, opts CsarOptions) (*CsarMeta, error) {
+	a, err := openCsar(r, size, opts.Limits)
+	if err != nil {
+		return nil, err
+	}
+
+	m, err := a.meta()
+	if err != nil {
+		return nil, err
+	}
+	if opts.Verify != nil {
+		if _, err = a.verify(m, *opts.Verify); err != nil {
+			return m, err
+		}
+	}
+
+	name := opts.Name
+	if name == "" {
+		name = "csar"
+	}
+
+	// pass in a resolver that has the context of the archive file to handle resolving
+	// imports, relative to the directory of the entry definitions
+	dir := path.Dir(m.EntryDefinitions)
+	p := &parser{
+		ctx: ctx,
+		resolver: func(ctx context.Context, l string) ([]byte, error) {
+			if u, err := url.Parse(l); err == nil && len(u.Scheme) > 1 {
+				if opts.Resolver == nil {
+					return nil, fmt.Errorf("%w: %s", ErrOutsideArchive, l)
+				}
+				return opts.Resolver(ctx, l)
+			}
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+			return a.read(path.Join(dir, path.Clean("/"+l)))
+		},
+		hooks:   opts.Hooks,
+		archive: name,
+	}
+	return m, p.parseSource(t, path.Base(m.EntryDefinitions))
+}
+
+// parseCsarMeta reads the blocks of "Key: value" lines of a TOSCA.meta file, the values are kept
+// as written, 1.0 is not a number. The first block describes the archive, each block starting
+// with a Name, or Source, key describes a file. A line starting with a space continues the
+// previous value.
+func parseCsarMeta(data []byte) (*CsarMeta, error) {
+	m := &CsarMeta{}
+	known := map[string]*string{
+		"TOSCA-Meta-File-Version": &m.MetaFileVersion,
+		"CSAR-Version":            &m.CsarVersion,
+		"Created-By":              &m.CreatedBy,
+		"Entry-Definitions":       &m.EntryDefinitions,
+		"ETSI-Entry-Manifest":     &m.EntryManifest,
+		"ETSI-Entry-Certificate":  &m.EntryCertificate,
+	}
+	extra := &m.Extra
+	var continued func(s string) // appends a continuation line to the last value
+	for i, line := range strings.Split(strings.Replace(string(data), "\r\n", "\n", -1), "\n") {
+		if strings.TrimSpace(line) == "" {
+			continued = nil
+			continue
+		}
+		if line[0] == ' ' && continued != nil {
+			continued(line[1:])
+			continue
+		}
+		j := strings.Index(line, ":")
+		if j <= 0 {
+			return nil, fmt.Errorf("invalid %s: line %d is not a Key: value pair", csarMetaFile, i+1)
+		}
+		k, v := strings.TrimSpace(line[:j]), strings.TrimSpace(line[j+1:])
+
+		if k == "Name" || k == "Source" {
+			// a new block describing a file of the archive
+			m.Entries = append(m.Entries, CsarEntry{})
+			e := &m.Entries[len(m.Entries)-1]
+			known = map[string]*string{k: &e.Name, "Content-Type": &e.ContentType, "Algorithm": &e.Algorithm, "Hash": &e.Hash}
+			extra = &e.Extra
+		}
+		if f, ok := known[k]; ok {
+			*f = v
+			continued = func(s string) { *f += s }
+			continue
+		}
+		if *extra == nil {
+			*extra = make(map[string]string)
+		}
+		fields := *extra
+		fields[k] = v
+		continued = func(s string) { fields[k] += s }
+	}
+	if m.EntryDefinitions == "" {
+		return nil, fmt.Errorf("%w: %s has no Entry-Definitions", ErrNoEntryDefinitions, csarMetaFile)
+	}
+	return m, nil
+}
+
+// rootMeta builds the metadata of an archive without TOSCA-Metadata directory from its single
+// YAML file at the root of the archive, which metadata must hold template_name and
+// template_version. The template_author is the creator of the archive.
+func (a csarArchive) rootMeta() (*CsarMeta, error) {
+	var roots []string
+	for _, name := range a.names() {
+		if ext := path.Ext(name); !strings.Contains(name, "/") && (ext == ".yaml" || ext == ".yml") {
+			roots = append(roots, name)
+		}
+	}
+	if len(roots) != 1 {
+		return nil, fmt.Errorf("%w: expected %s or a single YAML file at the root of the archive, found %d", ErrNoEntryDefinitions, csarMetaFile, len(roots))
+	}
+
+	data, err := a.read(roots[0])
+	if err != nil {
+		return nil, err
+	}
+	var doc struct {
+		Metadata Metadata `yaml:"metadata"`
+	}
+	if err = yaml.Unmarshal(data, &doc); err != nil {
+		return nil, &ParseError{Location: SourceLocation{File: roots[0]}, Err: err}
+	}
+	for _, k := range []string{"template_name", "template_version"} {
+		if doc.Metadata[k] == "" {
+			return nil, fmt.Errorf("%w: %s has no %s metadata", ErrNoEntryDefinitions, roots[0], k)
+		}
+	}
+
+	m := &CsarMeta{EntryDefinitions: roots[0], CreatedBy: doc.Metadata["template_author"]}
+	// the manifest and the certificate are named after the entry definitions
+	base := strings.TrimSuffix(roots[0], path.Ext(roots[0]))
+	if a.exists(base + ".mf") {
+		m.EntryManifest = base + ".mf"
+	}
+	if a.exists(base + ".cert") {
+		m.EntryCertificate = base + ".cert"
+	}
+	for k, v := range doc.Metadata {
+		if k != "template_author" {
+			if m.Extra == nil {
+				m.Extra = make(map[string]string)
+			}
+			m.Extra[k] = v
+		}
+	}
+	return m, nil
+}
+
+// csarArchive gives access to the files of a CSAR by their path within the archive
+type csarArchive struct {
+	*zip.Reader
+}
+
+// openCsar opens the archive of size bytes read from r and checks its entries against limits,
+// the zero limits being replaced by the default ones. The entries must be relative paths
+// within the archive.
+func openCsar(r io.ReaderAt, size int64, limits CsarLimits) (csarArchive, error) {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return csarArchive{}, err
+	}
+	if limits.MaxFiles == 0 {
+		limits.MaxFiles = DefaultCsarLimits.MaxFiles
+	}
+	if limits.MaxFileSize == 0 {
+		limits.MaxFileSize = DefaultCsarLimits.MaxFileSize
+	}
+	if limits.MaxSize == 0 {
+		limits.MaxSize = DefaultCsarLimits.MaxSize
+	}
+
+	if len(zr.File) > limits.MaxFiles {
+		return csarArchive{}, fmt.Errorf("%w: the archive has %d entries, the limit is %d", ErrTooLarge, len(zr.File), limits.MaxFiles)
+	}
+	// the zip reader fails when an entry holds more data than its declared size, checking the
+	// declared sizes is enough
+	var total uint64
+	for _, f := range zr.File {
+		name := strings.Replace(f.Name, "\\", "/", -1)
+		if clean := path.Clean(name); path.IsAbs(name) || clean == ".." || strings.HasPrefix(clean, "../") || (len(name) > 1 && name[1] == ':') {
+			return csarArchive{}, fmt.Errorf("%w: the entry %s is outside of the archive", ErrUnsafePath, f.Name)
+		}
+		if f.UncompressedSize64 > uint64(limits.MaxFileSize) {
+			return csarArchive{}, fmt.Errorf("%w: the entry %s exceeds %d bytes", ErrTooLarge, f.Name, limits.MaxFileSize)
+		}
+		if total += f.UncompressedSize64; total > uint64(limits.MaxSize) {
+			return csarArchive{}, fmt.Errorf("%w: the entries of the archive exceed %d bytes", ErrTooLarge, limits.MaxSize)
+		}
+	}
+	return csarArchive{zr}, nil
+}
+
+func (a csarArchive) read(name string) ([]byte, error) {
+	name = path.Clean("/" + name)[1:]
+	for _, f := range a.File {
+		if path.Clean("/" + f.Name)[1:] != name {
+			continue
+		}
+		rc, err := f.Open()
+		if err != nil {
+			return nil, err
+		}
+		defer rc.Close()
+		return ioutil.ReadAll(rc)
+	}
+	return nil, &os.PathError{Op: "open", Path: name, Err: os.ErrNotExist}
+}
+
+// exists reports if the archive holds the file name
+func (a csarArchive) exists(name string) bool {
+	name = path.Clean("/" + name)[1:]
+	for _, n := range a.names() {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+// names returns the sorted paths of the files of the archive
+func (a csarArchive) names() []string {
+	var names []string
+	for _, f := range a.File {
+		if !f.FileInfo().IsDir() {
+			names = append(names, path.Clean("/" + f.Name)[1:])
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// meta reads the TOSCA.meta file of the archive or, when the archive has no TOSCA-Metadata
+// directory, the metadata of its entry definitions.
+func (a csarArchive) meta() (*CsarMeta, error) {
+	out, err := a.read(csarMetaFile)
+	if err == nil {
+		return parseCsarMeta(out)
+	}
+	if !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+	for _, name := range a.names() {
+		if strings.HasPrefix(name, path.Dir(csarMetaFile)+"/") {
+			// the directory is there but not the file
+			return nil, err
+		}
+	}
+	return a.rootMeta()
+}
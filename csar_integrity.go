@@ -0,0 +1,351 @@
+package toscalib
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/url"
+	"path"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Errors returned by the verification of a CSAR, ErrInvalidSignature is returned as well
+var (
+	ErrDigestMismatch = errors.New("digest mismatch")
+	ErrUnsignedCsar   = errors.New("unsigned archive")
+)
+
+// cmsBlock starts the CMS signature ending a manifest
+const cmsBlock = "-----BEGIN CMS-----"
+
+// CsarDigest is a Source, Algorithm and Hash block of a manifest
+type CsarDigest struct {
+	Source    string // The path of the file in the archive.
+	Algorithm string // SHA-256 or SHA-512.
+	Hash      string // The hexadecimal digest of the file.
+}
+
+// CsarManifest is the manifest (.mf) of a CSAR, listing the digests of its files
+type CsarManifest struct {
+	Metadata  map[string]string
+	Digests   []CsarDigest
+	Signature []byte // The PEM encoded CMS signature ending the manifest, if any.
+	signed    []byte // the content covered by the signature
+}
+
+// CsarVerifyOptions configures the verification of a CSAR
+type CsarVerifyOptions struct {
+	// Roots are the certificates trusted to sign archives, the system roots are never used.
+	Roots *x509.CertPool
+	// RequireSignature rejects the archives whose manifest is not signed.
+	RequireSignature bool
+	// CurrentTime is the time the certificates must be valid at, now by default.
+	CurrentTime time.Time
+	// Limits bounds the content of the archive read by VerifyCsar, DefaultCsarLimits by default.
+	// ParseCsarReader uses the Limits of its CsarOptions.
+	Limits CsarLimits
+}
+
+// CsarVerification is the result of the verification of a CSAR
+type CsarVerification struct {
+	Manifest string            // The path of the manifest, if any.
+	Verified []string          // The files whose digest matched.
+	Signer   *x509.Certificate // The certificate of the signer of the manifest, nil when not signed.
+}
+
+// LoadCertPool reads the PEM or DER encoded certificates of files, to be used as the trust roots
+// of CsarVerifyOptions.
+func LoadCertPool(files ...string) (*x509.CertPool, error) {
+	pool := x509.NewCertPool()
+	for _, f := range files {
+		data, err := ioutil.ReadFile(f)
+		if err != nil {
+			return nil, err
+		}
+		certs, err := parseCertificates(data)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", f, err)
+		}
+		for _, c := range certs {
+			pool.AddCert(c)
+		}
+	}
+	return pool, nil
+}
+
+// parseCertificates reads PEM encoded certificates, or DER encoded ones
+func parseCertificates(data []byte) ([]*x509.Certificate, error) {
+	var certs []*x509.Certificate
+	for block, rest := pem.Decode(data); block != nil; block, rest = pem.Decode(rest) {
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		c, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		certs = append(certs, c)
+	}
+	if len(certs) != 0 {
+		return certs, nil
+	}
+	return x509.ParseCertificates(data)
+}
+
+// VerifyCsar checks the digests of the files of the CSAR of size bytes read from r, listed by
+// its manifest and its TOSCA.meta, and the signature of its manifest. The files of a signed
+// archive must all be listed by the manifest, except TOSCA.meta, the manifest itself, its
+// signature and certificates.
+//
+// The manifest is designated by ETSI-Entry-Manifest, or named after the entry definitions of an
+// archive without TOSCA-Metadata. It is signed by a CMS block ending it, a detached CMS signature
+// <manifest>.sig.cms or a signature <manifest>.sig made with the key of the first certificate of
+// ETSI-Entry-Certificate.
+func VerifyCsar(r io.ReaderAt, size int64, opts CsarVerifyOptions) (*CsarVerification, error) {
+	a, err := openCsar(r, size, opts.Limits)
+	if err != nil {
+		return nil, err
+	}
+	m, err := a.meta()
+	if err != nil {
+		return nil, err
+	}
+	return a.verify(m, opts)
+}
+
+func (a csarArchive) verify(m *CsarMeta, opts CsarVerifyOptions) (*CsarVerification, error) {
+	v := &CsarVerification{Manifest: m.EntryManifest}
+	if opts.CurrentTime.IsZero() {
+		opts.CurrentTime = time.Now()
+	}
+
+	var digests []CsarDigest
+	for _, e := range m.Entries {
+		if e.Hash != "" {
+			digests = append(digests, CsarDigest{Source: e.Name, Algorithm: e.Algorithm, Hash: e.Hash})
+		}
+	}
+
+	listed := make(map[string]bool) // the files listed by the manifest
+	exempt := map[string]bool{csarMetaFile: true}
+	if m.EntryManifest != "" {
+		data, err := a.read(m.EntryManifest)
+		if err != nil {
+			return nil, err
+		}
+		mf, err := parseCsarManifest(data)
+		if err != nil {
+			return nil, err
+		}
+		for _, d := range mf.Digests {
+			listed[path.Clean("/" + d.Source)[1:]] = true
+		}
+		digests = append(digests, mf.Digests...)
+		exempt[path.Clean("/" + m.EntryManifest)[1:]] = true
+
+		if v.Signer, err = a.verifyManifest(m, mf, data, opts, exempt); err != nil {
+			return nil, err
+		}
+	}
+	if v.Signer == nil && opts.RequireSignature {
+		return nil, ErrUnsignedCsar
+	}
+
+	for _, d := range digests {
+		if u, err := url.Parse(d.Source); err == nil && len(u.Scheme) > 1 {
+			// an external artifact, not part of the archive
+			continue
+		}
+		data, err := a.read(d.Source)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrDigestMismatch, err)
+		}
+		if err = d.verify(data); err != nil {
+			return nil, err
+		}
+		v.Verified = append(v.Verified, path.Clean("/" + d.Source)[1:])
+	}
+	sort.Strings(v.Verified)
+
+	if v.Signer != nil {
+		// a file missing from a signed manifest may have been added afterwards
+		for _, name := range a.names() {
+			if !listed[name] && !exempt[name] {
+				return nil, fmt.Errorf("%w: %s is not listed by the manifest", ErrDigestMismatch, name)
+			}
+		}
+	}
+	return v, nil
+}
+
+// verifyManifest checks the signature of the manifest, if any, and returns its signer. The files
+// holding the signature and the certificates are added to exempt.
+func (a csarArchive) verifyManifest(m *CsarMeta, mf *CsarManifest, data []byte, opts CsarVerifyOptions, exempt map[string]bool) (*x509.Certificate, error) {
+	var certs []*x509.Certificate
+	certFile := m.EntryCertificate
+	if certFile == "" {
+		certFile = strings.TrimSuffix(m.EntryManifest, path.Ext(m.EntryManifest)) + ".cert"
+	}
+	if out, err := a.read(certFile); err == nil {
+		exempt[path.Clean("/" + certFile)[1:]] = true
+		if certs, err = parseCertificates(out); err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrInvalidSignature, certFile, err)
+		}
+	}
+
+	if mf.Signature != nil {
+		return verifyCMS(mf.Signature, mf.signed, opts.Roots, certs, opts.CurrentTime)
+	}
+	name := path.Clean("/" + m.EntryManifest)[1:]
+	if sig, err := a.read(name + ".sig.cms"); err == nil {
+		exempt[name+".sig.cms"] = true
+		return verifyCMS(sig, data, opts.Roots, certs, opts.CurrentTime)
+	}
+	if sig, err := a.read(name + ".sig"); err == nil {
+		exempt[name+".sig"] = true
+		if len(certs) == 0 {
+			return nil, fmt.Errorf("%w: no certificate for %s.sig", ErrInvalidSignature, name)
+		}
+		return certs[0], verifySignature(decodeSignature(sig), data, certs, opts.Roots, opts.CurrentTime)
+	}
+	return nil, nil
+}
+
+// decodeSignature returns a signature which may be base64 encoded
+func decodeSignature(data []byte) []byte {
+	if sig, err := base64.StdEncoding.DecodeString(string(bytes.TrimSpace(data))); err == nil {
+		return sig
+	}
+	return data
+}
+
+// verifySignature checks a plain signature of content made with the key of certs[0], using
+// SHA-256 for the RSA and ECDSA keys, and that certs[0] chains up to roots.
+func verifySignature(sig, content []byte, certs []*x509.Certificate, roots *x509.CertPool, now time.Time) error {
+	cert := certs[0]
+	var alg x509.SignatureAlgorithm
+	switch cert.PublicKey.(type) {
+	case *rsa.PublicKey:
+		alg = x509.SHA256WithRSA
+	case *ecdsa.PublicKey:
+		alg = x509.ECDSAWithSHA256
+	case ed25519.PublicKey:
+		alg = x509.PureEd25519
+	default:
+		return fmt.Errorf("%w: unsupported key %T", ErrInvalidSignature, cert.PublicKey)
+	}
+	if err := cert.CheckSignature(alg, content, sig); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidSignature, err)
+	}
+	return verifyChain(cert, roots, certs[1:], now)
+}
+
+// digest returns the hexadecimal digest of data
+func digest(algorithm string, data []byte) (string, error) {
+	switch strings.ToUpper(strings.Replace(algorithm, "_", "-", -1)) {
+	case "SHA-256":
+		sum := sha256.Sum256(data)
+		return hex.EncodeToString(sum[:]), nil
+	case "SHA-512":
+		sum := sha512.Sum512(data)
+		return hex.EncodeToString(sum[:]), nil
+	}
+	return "", fmt.Errorf("unsupported digest algorithm %s", algorithm)
+}
+
+func (d CsarDigest) verify(data []byte) error {
+	sum, err := digest(d.Algorithm, data)
+	if err != nil {
+		return fmt.Errorf("%w: %s: %v", ErrDigestMismatch, d.Source, err)
+	}
+	if !strings.EqualFold(sum, d.Hash) {
+		return fmt.Errorf("%w: %s", ErrDigestMismatch, d.Source)
+	}
+	return nil
+}
+
+// parseCsarManifest reads a manifest: a metadata section of indented "key: value" lines,
+// followed by Source, Algorithm and Hash blocks and by the CMS signature of the manifest, if any.
+// The other sections are ignored.
+func parseCsarManifest(data []byte) (*CsarManifest, error) {
+	m := &CsarManifest{}
+	text := data
+	if i := bytes.Index(data, []byte(cmsBlock)); i >= 0 {
+		m.signed, m.Signature = data[:i], data[i:]
+		text = data[:i]
+	}
+
+	section := ""
+	for i, line := range strings.Split(strings.Replace(string(text), "\r\n", "\n", -1), "\n") {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		j := strings.Index(line, ":")
+		if j <= 0 {
+			return nil, fmt.Errorf("invalid manifest: line %d is not a key: value pair", i+1)
+		}
+		k, v := strings.TrimSpace(line[:j]), strings.TrimSpace(line[j+1:])
+		if line[0] == ' ' || line[0] == '\t' {
+			if section == "metadata" {
+				m.Metadata[k] = v
+			}
+			continue
+		}
+
+		section = ""
+		switch k {
+		case "metadata":
+			section = k
+			m.Metadata = make(map[string]string)
+		case "Source":
+			m.Digests = append(m.Digests, CsarDigest{Source: v})
+		case "Algorithm", "Hash":
+			if len(m.Digests) == 0 {
+				return nil, fmt.Errorf("invalid manifest: line %d: %s without Source", i+1, k)
+			}
+			d := &m.Digests[len(m.Digests)-1]
+			if k == "Algorithm" {
+				d.Algorithm = v
+			} else {
+				d.Hash = v
+			}
+		default:
+			if v == "" {
+				// another section, such as non_mano_artifact_sets
+				section = k
+			}
+		}
+	}
+	return m, nil
+}
+
+// Bytes returns the content of the manifest described by m, without its signature
+func (m *CsarManifest) Bytes() []byte {
+	var buf bytes.Buffer
+	if len(m.Metadata) != 0 {
+		buf.WriteString("metadata:\n")
+		for _, k := range sortedKeys(m.Metadata) {
+			fmt.Fprintf(&buf, "  %s: %s\n", k, m.Metadata[k])
+		}
+	}
+	for _, d := range m.Digests {
+		if buf.Len() != 0 {
+			buf.WriteString("\n")
+		}
+		fmt.Fprintf(&buf, "Source: %s\nAlgorithm: %s\nHash: %s\n", d.Source, d.Algorithm, d.Hash)
+	}
+	return buf.Bytes()
+}
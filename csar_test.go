@@ -0,0 +1,459 @@
+package toscalib
+
+import (
+	"archive/zip"
+	"bytes"
+	"compress/flate"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// zipFiles returns a zip archive holding files
+func zipFiles(t *testing.T, files map[string]string) []byte {
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for _, name := range sortedKeys(files) {
+		f, err := w.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err = f.Write([]byte(files[name])); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestParseCsarReader(t *testing.T) {
+	fname := "tests/csar_single_instance_wordpress.zip"
+	data, err := ioutil.ReadFile(fname)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var sources []string
+	hooks := ParserHooks{ParsedSTD: func(source string, std *ServiceTemplateDefinition) error {
+		sources = append(sources, source)
+		return nil
+	}}
+
+	var s ServiceTemplateDefinition
+	m, err := s.ParseCsarReader(context.Background(), bytes.NewReader(data), int64(len(data)), CsarOptions{Hooks: hooks, Name: "upload.zip"})
+	if err != nil {
+		t.Fatalf("ParseCsarReader(%s): %v", fname, err)
+	}
+	expected := &CsarMeta{
+		MetaFileVersion:  "1.0",
+		CsarVersion:      "1.1",
+		CreatedBy:        "OASIS TOSCA TC",
+		EntryDefinitions: "Definitions/tosca_single_instance_wordpress.yaml",
+		Extra:            map[string]string{"Content-Type": "application/vnd.oasis.tosca.definitions.yaml"},
+	}
+	if !reflect.DeepEqual(m, expected) {
+		t.Errorf("ParseCsarReader(%s): expected metadata %+v, actual %+v", fname, expected, m)
+	}
+	if len(sources) == 0 || sources[len(sources)-1] != "wordpress.yaml" {
+		t.Errorf("ParseCsarReader(%s): expected the hooks to be called for wordpress.yaml, actual %v", fname, sources)
+	}
+	if loc := s.locate("topology_template"); loc.Archive != "upload.zip" {
+		t.Errorf("ParseCsarReader(%s): expected locations in upload.zip, actual %v", fname, loc)
+	}
+}
+
+func TestParseCsarRemoteImports(t *testing.T) {
+	data := zipFiles(t, map[string]string{
+		"TOSCA-Metadata/TOSCA.meta": "TOSCA-Meta-File-Version: 1.0\nCSAR-Version: 1.1\nCreated-By: toscalib\nEntry-Definitions: Definitions/main.yaml\n",
+		"Definitions/main.yaml": `tosca_definitions_version: tosca_simple_yaml_1_0
+imports:
+  - https://raw.githubusercontent.com/openstack/heat-translator/master/translator/tests/data/custom_types/wordpress.yaml
+`,
+	})
+
+	var s ServiceTemplateDefinition
+	_, err := s.ParseCsarReader(context.Background(), bytes.NewReader(data), int64(len(data)), CsarOptions{})
+	if !errors.Is(err, ErrOutsideArchive) {
+		t.Errorf("ParseCsarReader: expected %v without resolver, actual %v", ErrOutsideArchive, err)
+	}
+
+	opts := CsarOptions{Resolver: OfflineResolver("tests/mirror").Resolve}
+	if _, err = s.ParseCsarReader(context.Background(), bytes.NewReader(data), int64(len(data)), opts); err != nil {
+		t.Fatalf("ParseCsarReader: %v", err)
+	}
+	if _, ok := s.NodeTypes["tosca.nodes.WebApplication.WordPress"]; !ok {
+		t.Error("ParseCsarReader: missing the imported tosca.nodes.WebApplication.WordPress")
+	}
+}
+
+func TestParseCsarMeta(t *testing.T) {
+	data := "TOSCA-Meta-File-Version: 1.0\r\n" +
+		"CSAR-Version: 1.1\r\n" +
+		"Created-By: toscalib\r\n" +
+		"Entry-Definitions: Definitions/main.yaml\r\n" +
+		"Description: an archive described\r\n" +
+		"  over two lines\r\n" +
+		"\r\n" +
+		"Name: Scripts/install.sh\r\n" +
+		"Content-Type: application/x-sh\r\n" +
+		"\r\n" +
+		"Name: Images/disk.qcow2\r\n" +
+		"Content-Type: application/octet-stream\r\n" +
+		"SHA-256: 0123abcd\r\n"
+
+	m, err := parseCsarMeta([]byte(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := &CsarMeta{
+		MetaFileVersion:  "1.0",
+		CsarVersion:      "1.1",
+		CreatedBy:        "toscalib",
+		EntryDefinitions: "Definitions/main.yaml",
+		Extra:            map[string]string{"Description": "an archive described over two lines"},
+		Entries: []CsarEntry{
+			{Name: "Scripts/install.sh", ContentType: "application/x-sh"},
+			{Name: "Images/disk.qcow2", ContentType: "application/octet-stream", Extra: map[string]string{"SHA-256": "0123abcd"}},
+		},
+	}
+	if !reflect.DeepEqual(m, expected) {
+		t.Errorf("parseCsarMeta: expected %+v, actual %+v", expected, m)
+	}
+
+	if _, err = parseCsarMeta([]byte("CSAR-Version: 1.1\n")); !errors.Is(err, ErrNoEntryDefinitions) {
+		t.Errorf("parseCsarMeta: expected %v, actual %v", ErrNoEntryDefinitions, err)
+	}
+}
+
+func TestParseCsarWithoutMetadata(t *testing.T) {
+	main := `tosca_definitions_version: tosca_simple_yaml_1_0
+metadata:
+  template_name: hello
+  template_author: toscalib
+  template_version: 1.0
+imports:
+  - Definitions/types.yaml
+topology_template:
+  node_templates:
+    app:
+      type: tosca.nodes.Hello
+`
+	types := `tosca_definitions_version: tosca_simple_yaml_1_0
+node_types:
+  tosca.nodes.Hello:
+    derived_from: tosca.nodes.Root
+`
+	var testCases = []struct {
+		name  string
+		files map[string]string
+		err   error
+	}{
+		{"single root YAML", map[string]string{"hello.yaml": main, "Definitions/types.yaml": types}, nil},
+		{"several root YAML", map[string]string{"hello.yaml": main, "other.yml": main, "Definitions/types.yaml": types}, ErrNoEntryDefinitions},
+		{"no metadata", map[string]string{"hello.yaml": types}, ErrNoEntryDefinitions},
+		{"no TOSCA.meta", map[string]string{"hello.yaml": main, "Definitions/types.yaml": types, "TOSCA-Metadata/README": ""}, os.ErrNotExist},
+	}
+
+	for _, tc := range testCases {
+		data := zipFiles(t, tc.files)
+		var s ServiceTemplateDefinition
+		m, err := s.ParseCsarReader(context.Background(), bytes.NewReader(data), int64(len(data)), CsarOptions{})
+		if tc.err != nil {
+			if !errors.Is(err, tc.err) {
+				t.Errorf("%s: expected %v, actual %v", tc.name, tc.err, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: %v", tc.name, err)
+			continue
+		}
+		expected := &CsarMeta{
+			CreatedBy:        "toscalib",
+			EntryDefinitions: "hello.yaml",
+			Extra:            map[string]string{"template_name": "hello", "template_version": "1.0"},
+		}
+		if !reflect.DeepEqual(m, expected) {
+			t.Errorf("%s: expected %+v, actual %+v", tc.name, expected, m)
+		}
+		if s.GetNodeTemplate("app") == nil {
+			t.Errorf("%s: missing node template app", tc.name)
+		}
+	}
+}
+
+func TestWriteCsar(t *testing.T) {
+	fname := "tests/csar_build/service.yaml"
+	var buf bytes.Buffer
+	m, err := WriteCsar(&buf, fname, CsarWriteOptions{CreatedBy: "pipeline"})
+	if err != nil {
+		t.Fatalf("WriteCsar(%s): %v", fname, err)
+	}
+
+	var names []string
+	for _, e := range m.Entries {
+		names = append(names, e.Name+" "+e.ContentType)
+	}
+	expected := []string{
+		"Definitions/external/common.yaml " + toscaContentType,
+		"Definitions/files/app.conf application/octet-stream",
+		"Definitions/scripts/configure.sh application/x-sh",
+		"Definitions/scripts/create.sh application/x-sh",
+		"Definitions/scripts/start.sh application/x-sh",
+		"Definitions/types/app.yaml " + toscaContentType,
+	}
+	if m.EntryDefinitions != "Definitions/service.yaml" || m.CreatedBy != "pipeline" || !reflect.DeepEqual(names, expected) {
+		t.Errorf("WriteCsar(%s): expected the entries %v, actual %s %v", fname, expected, m.EntryDefinitions, names)
+	}
+
+	// the archive is parsed back with the metadata written
+	data := buf.Bytes()
+	var s ServiceTemplateDefinition
+	read, err := s.ParseCsarReader(context.Background(), bytes.NewReader(data), int64(len(data)), CsarOptions{})
+	if err != nil {
+		t.Fatalf("ParseCsarReader(%s): %v", fname, err)
+	}
+	if !reflect.DeepEqual(read, m) {
+		t.Errorf("ParseCsarReader(%s): expected %+v, actual %+v", fname, m, read)
+	}
+	if _, ok := s.NodeTypes["tosca.nodes.Common"]; !ok {
+		t.Errorf("ParseCsarReader(%s): missing the type of the external import", fname)
+	}
+	if f := s.GetNodeTemplate("app").Artifacts["config"].File; f != "files/app.conf" {
+		t.Errorf("ParseCsarReader(%s): expected artifact files/app.conf, actual %s", fname, f)
+	}
+
+	// a missing file is reported
+	if _, err = WriteCsar(ioutil.Discard, "tests/imports/app_a.yaml", CsarWriteOptions{}); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("WriteCsar: expected %v, actual %v", os.ErrNotExist, err)
+	}
+}
+
+// testCertificate returns a certificate, with its key, issued by parent or self-signed when nil
+func testCertificate(t *testing.T, name string, parent *x509.Certificate, parentKey crypto.Signer) (*x509.Certificate, crypto.Signer) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+	}
+	if parent == nil {
+		tmpl.IsCA = true
+		tmpl.KeyUsage |= x509.KeyUsageCertSign
+		parent, parentKey = tmpl, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, parent, key.Public(), parentKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cert, key
+}
+
+// rezip returns a copy of the archive data with the files of changes replaced, or added
+func rezip(t *testing.T, data []byte, changes map[string]string) []byte {
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	files := make(map[string]string)
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		b, _ := ioutil.ReadAll(rc)
+		rc.Close()
+		files[f.Name] = string(b)
+	}
+	for name, content := range changes {
+		files[name] = content
+	}
+	return zipFiles(t, files)
+}
+
+func TestVerifyCsar(t *testing.T) {
+	ca, caKey := testCertificate(t, "CA", nil, nil)
+	signer, key := testCertificate(t, "vendor", ca, caKey)
+	other, _ := testCertificate(t, "other CA", nil, nil)
+	roots := x509.NewCertPool()
+	roots.AddCert(ca)
+	untrusted := x509.NewCertPool()
+	untrusted.AddCert(other)
+
+	fname := "tests/csar_build/service.yaml"
+	var signed, digested bytes.Buffer
+	if _, err := WriteCsar(&signed, fname, CsarWriteOptions{Signer: key, Certificates: []*x509.Certificate{signer}}); err != nil {
+		t.Fatalf("WriteCsar(%s): %v", fname, err)
+	}
+	if _, err := WriteCsar(&digested, fname, CsarWriteOptions{Digest: "SHA-512"}); err != nil {
+		t.Fatalf("WriteCsar(%s): %v", fname, err)
+	}
+
+	var testCases = []struct {
+		name string
+		data []byte
+		opts CsarVerifyOptions
+		err  error
+	}{
+		{"signed", signed.Bytes(), CsarVerifyOptions{Roots: roots, RequireSignature: true}, nil},
+		{"untrusted signer", signed.Bytes(), CsarVerifyOptions{Roots: untrusted}, ErrInvalidSignature},
+		{"expired signer", signed.Bytes(), CsarVerifyOptions{Roots: roots, CurrentTime: time.Now().Add(2 * time.Hour)}, ErrInvalidSignature},
+		{"modified file", rezip(t, signed.Bytes(), map[string]string{"Definitions/scripts/create.sh": "rm -rf /"}), CsarVerifyOptions{Roots: roots}, ErrDigestMismatch},
+		{"added file", rezip(t, signed.Bytes(), map[string]string{"Definitions/scripts/backdoor.sh": "nc -l"}), CsarVerifyOptions{Roots: roots}, ErrDigestMismatch},
+		{"modified manifest", rezip(t, signed.Bytes(), map[string]string{"service.mf": "Source: Definitions/service.yaml\n"}), CsarVerifyOptions{Roots: roots, RequireSignature: true}, ErrUnsignedCsar},
+		{"digests", digested.Bytes(), CsarVerifyOptions{}, nil},
+		{"digests unsigned", digested.Bytes(), CsarVerifyOptions{RequireSignature: true}, ErrUnsignedCsar},
+		{"digests modified file", rezip(t, digested.Bytes(), map[string]string{"Definitions/files/app.conf": "listen = 22\n"}), CsarVerifyOptions{}, ErrDigestMismatch},
+	}
+
+	for _, tc := range testCases {
+		v, err := VerifyCsar(bytes.NewReader(tc.data), int64(len(tc.data)), tc.opts)
+		if tc.err != nil {
+			if !errors.Is(err, tc.err) {
+				t.Errorf("%s: expected %v, actual %v", tc.name, tc.err, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: %v", tc.name, err)
+			continue
+		}
+		if v.Manifest != "service.mf" || len(v.Verified) != 8 {
+			t.Errorf("%s: expected 8 files verified by service.mf, actual %s %v", tc.name, v.Manifest, v.Verified)
+		}
+		if tc.opts.RequireSignature && (v.Signer == nil || v.Signer.Subject.CommonName != "vendor") {
+			t.Errorf("%s: expected the vendor signer, actual %v", tc.name, v.Signer)
+		}
+	}
+
+	// the verification is part of the parsing
+	data := rezip(t, signed.Bytes(), map[string]string{"Definitions/types/app.yaml": "tosca_definitions_version: tosca_simple_yaml_1_0\n"})
+	var s ServiceTemplateDefinition
+	opts := CsarOptions{Verify: &CsarVerifyOptions{Roots: roots}}
+	if _, err := s.ParseCsarReader(context.Background(), bytes.NewReader(data), int64(len(data)), opts); !errors.Is(err, ErrDigestMismatch) {
+		t.Errorf("ParseCsarReader: expected %v, actual %v", ErrDigestMismatch, err)
+	}
+}
+
+func TestVerifyCsarSignatures(t *testing.T) {
+	roots, err := LoadCertPool("tests/pki/ca.pem")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// signed by openssl cms with a detached signature
+	fname := "tests/csar_signed.zip"
+	f, err := os.Open(fname)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	fi, _ := f.Stat()
+	v, err := VerifyCsar(f, fi.Size(), CsarVerifyOptions{Roots: roots, RequireSignature: true})
+	if err != nil {
+		t.Fatalf("VerifyCsar(%s): %v", fname, err)
+	}
+	if v.Signer.Subject.CommonName != "toscalib test vendor" || !reflect.DeepEqual(v.Verified, []string{"Definitions/hello.yaml"}) {
+		t.Errorf("VerifyCsar(%s): unexpected verification %v %v", fname, v.Signer.Subject, v.Verified)
+	}
+
+	// a plain signature made with the key of the certificate
+	ca, caKey := testCertificate(t, "CA", nil, nil)
+	signer, key := testCertificate(t, "vendor", ca, caKey)
+	pool := x509.NewCertPool()
+	pool.AddCert(ca)
+
+	entry := "tosca_definitions_version: tosca_simple_yaml_1_0\nmetadata:\n  template_name: hello\n  template_version: 1.0\n"
+	sum := sha256.Sum256([]byte(entry))
+	manifest := "Source: hello.yaml\nAlgorithm: SHA-256\nHash: " + hex.EncodeToString(sum[:]) + "\n"
+	h := sha256.Sum256([]byte(manifest))
+	sig, err := key.Sign(rand.Reader, h[:], crypto.SHA256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := zipFiles(t, map[string]string{
+		"hello.yaml":   entry,
+		"hello.mf":     manifest,
+		"hello.mf.sig": base64.StdEncoding.EncodeToString(sig),
+		"hello.cert":   string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: signer.Raw})),
+	})
+	v, err = VerifyCsar(bytes.NewReader(data), int64(len(data)), CsarVerifyOptions{Roots: pool, RequireSignature: true})
+	if err != nil {
+		t.Fatalf("VerifyCsar: %v", err)
+	}
+	if v.Manifest != "hello.mf" || v.Signer.Subject.CommonName != "vendor" {
+		t.Errorf("VerifyCsar: unexpected verification %s %v", v.Manifest, v.Signer.Subject)
+	}
+}
+
+func TestParseCsarLimits(t *testing.T) {
+	entry := "tosca_definitions_version: tosca_simple_yaml_1_0\nmetadata:\n  template_name: hello\n  template_version: 1.0\n"
+	bomb := zipFiles(t, map[string]string{"hello.yaml": entry, "zeros.bin": strings.Repeat("\x00", 10<<20)})
+
+	// an entry larger than declared
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	var deflated bytes.Buffer
+	fw, _ := flate.NewWriter(&deflated, flate.BestCompression)
+	fw.Write([]byte(strings.Repeat("\x00", 1<<20) + entry))
+	fw.Close()
+	w, err := zw.CreateRaw(&zip.FileHeader{Name: "hello.yaml", Method: zip.Deflate, CompressedSize64: uint64(deflated.Len()), UncompressedSize64: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	w.Write(deflated.Bytes())
+	zw.Close()
+
+	testCases := []struct {
+		name   string
+		data   []byte
+		limits CsarLimits
+		err    error
+	}{
+		{"files", zipFiles(t, map[string]string{"hello.yaml": entry, "a": "", "b": ""}), CsarLimits{MaxFiles: 2}, ErrTooLarge},
+		{"file size", bomb, CsarLimits{MaxFileSize: 1 << 20}, ErrTooLarge},
+		{"total size", bomb, CsarLimits{MaxSize: 10 << 20}, ErrTooLarge},
+		{"within limits", bomb, CsarLimits{}, nil},
+		{"parent entry", zipFiles(t, map[string]string{"hello.yaml": entry, "../../etc/cron.d/job": ""}), CsarLimits{}, ErrUnsafePath},
+		{"absolute entry", zipFiles(t, map[string]string{"hello.yaml": entry, "/etc/passwd": ""}), CsarLimits{}, ErrUnsafePath},
+		{"windows entry", zipFiles(t, map[string]string{"hello.yaml": entry, `..\evil.bat`: ""}), CsarLimits{}, ErrUnsafePath},
+		{"undeclared size", buf.Bytes(), CsarLimits{}, zip.ErrFormat},
+	}
+	for _, tc := range testCases {
+		var s ServiceTemplateDefinition
+		_, err := s.ParseCsarReader(context.Background(), bytes.NewReader(tc.data), int64(len(tc.data)), CsarOptions{Limits: tc.limits})
+		if tc.err == nil && err != nil {
+			t.Errorf("%s: %v", tc.name, err)
+		} else if !errors.Is(err, tc.err) {
+			t.Errorf("%s: expected %v, actual %v", tc.name, tc.err, err)
+		}
+	}
+}
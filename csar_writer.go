@@ -0,0 +1,478 @@
+package toscalib
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	yaml3 "gopkg.in/yaml.v3"
+)
+
+// csarDefinitionsDir is the directory of the archive holding the entry definitions, the other
+// files are laid out relative to it.
+const csarDefinitionsDir = "Definitions"
+
+// toscaContentType is the Content-Type of the TOSCA documents
+const toscaContentType = "application/vnd.oasis.tosca.definitions.yaml"
+
+// CsarWriteOptions configures the CSAR written by WriteCsar
+type CsarWriteOptions struct {
+	CreatedBy   string   // Created-By, toscalib by default.
+	CsarVersion string   // CSAR-Version, 1.1 by default.
+	Files       []string // Other files to package, relative to the directory of the service template.
+
+	// Digest is the algorithm, SHA-256 or SHA-512, of the digests listed by the manifest of the
+	// archive. The manifest is written when Digest or Signer is set, with SHA-256 by default.
+	Digest string
+	// Signer signs the manifest with the key of the first of Certificates, the certificates
+	// are written in the archive after the signer's one.
+	Signer       crypto.Signer
+	Certificates []*x509.Certificate
+}
+
+// WriteCsar packages the service template at source in a CSAR written to w, with the documents
+// it imports and the files of its artifacts and operation implementations, and returns the
+// content of the TOSCA.meta written. Remote files and files of repositories are not packaged.
+//
+// The service template is the entry definitions of the archive, in the Definitions directory.
+// The files are laid out relative to it as they are relative to the service template, a file
+// outside of its directory is moved to Definitions/external and the paths referring to it are
+// rewritten.
+func WriteCsar(w io.Writer, source string, opts CsarWriteOptions) (*CsarMeta, error) {
+	abs, err := filepath.Abs(source)
+	if err != nil {
+		return nil, err
+	}
+	cw := &csarWriter{
+		root:      filepath.Dir(abs),
+		paths:     make(map[string]string),
+		taken:     make(map[string]bool),
+		contents:  make(map[string][]byte),
+		documents: make(map[string]bool),
+	}
+	cw.paths[abs] = path.Join(csarDefinitionsDir, filepath.Base(abs))
+	cw.taken[cw.paths[abs]] = true
+	if err = cw.addDocument(abs); err != nil {
+		return nil, err
+	}
+	for _, f := range opts.Files {
+		if _, err = cw.add(f, false); err != nil {
+			return nil, err
+		}
+	}
+
+	m := &CsarMeta{
+		MetaFileVersion:  "1.1",
+		CsarVersion:      opts.CsarVersion,
+		CreatedBy:        opts.CreatedBy,
+		EntryDefinitions: cw.paths[abs],
+	}
+	if m.CsarVersion == "" {
+		m.CsarVersion = "1.1"
+	}
+	if m.CreatedBy == "" {
+		m.CreatedBy = "toscalib"
+	}
+	// the manifest and the certificates are named after the service template
+	base := strings.TrimSuffix(filepath.Base(abs), filepath.Ext(abs))
+	if opts.Signer != nil {
+		if len(opts.Certificates) == 0 {
+			return nil, errors.New("signing requires the certificate of the signer")
+		}
+		m.EntryCertificate = base + ".cert"
+		var buf bytes.Buffer
+		for _, c := range opts.Certificates {
+			if err = pem.Encode(&buf, &pem.Block{Type: "CERTIFICATE", Bytes: c.Raw}); err != nil {
+				return nil, err
+			}
+		}
+		cw.contents[m.EntryCertificate] = buf.Bytes()
+	}
+	for _, name := range sortedKeys(cw.contents) {
+		if name != m.EntryDefinitions {
+			m.Entries = append(m.Entries, CsarEntry{Name: name, ContentType: cw.contentType(name)})
+		}
+	}
+
+	if opts.Digest != "" || opts.Signer != nil {
+		m.EntryManifest = base + ".mf"
+	}
+	cw.contents[csarMetaFile] = m.Bytes()
+	if m.EntryManifest != "" {
+		if cw.contents[m.EntryManifest], err = cw.manifest(m, opts); err != nil {
+			return nil, err
+		}
+	}
+
+	// TOSCA.meta is the first file of the archive
+	names := append([]string{csarMetaFile}, sortedKeys(cw.contents)...)
+	zw := zip.NewWriter(w)
+	for i, name := range names {
+		if i != 0 && name == csarMetaFile {
+			continue
+		}
+		if err = writeZipFile(zw, name, cw.contents[name]); err != nil {
+			return nil, err
+		}
+	}
+	return m, zw.Close()
+}
+
+// manifest returns the manifest listing the digests of the files of the archive, signed when
+// opts.Signer is set.
+func (cw *csarWriter) manifest(m *CsarMeta, opts CsarWriteOptions) ([]byte, error) {
+	alg := opts.Digest
+	if alg == "" {
+		alg = "SHA-256"
+	}
+	mf := &CsarManifest{}
+	for _, name := range sortedKeys(cw.contents) {
+		if name == m.EntryManifest || name == m.EntryCertificate {
+			continue
+		}
+		sum, err := digest(alg, cw.contents[name])
+		if err != nil {
+			return nil, err
+		}
+		mf.Digests = append(mf.Digests, CsarDigest{Source: name, Algorithm: alg, Hash: sum})
+	}
+
+	data := mf.Bytes()
+	if opts.Signer == nil {
+		return data, nil
+	}
+	// the signature covers the manifest up to its CMS block
+	data = append(data, '\n')
+	sig, err := signCMS(data, opts.Signer, opts.Certificates)
+	if err != nil {
+		return nil, err
+	}
+	return append(data, pem.EncodeToMemory(&pem.Block{Type: "CMS", Bytes: sig})...), nil
+}
+
+func writeZipFile(zw *zip.Writer, name string, data []byte) error {
+	f, err := zw.Create(name)
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(data)
+	return err
+}
+
+// Bytes returns the content of the TOSCA.meta file described by m
+func (m *CsarMeta) Bytes() []byte {
+	var buf bytes.Buffer
+	field := func(k, v string) {
+		if v != "" {
+			fmt.Fprintf(&buf, "%s: %s\n", k, v)
+		}
+	}
+	field("TOSCA-Meta-File-Version", m.MetaFileVersion)
+	field("CSAR-Version", m.CsarVersion)
+	field("Created-By", m.CreatedBy)
+	field("Entry-Definitions", m.EntryDefinitions)
+	field("ETSI-Entry-Manifest", m.EntryManifest)
+	field("ETSI-Entry-Certificate", m.EntryCertificate)
+	for _, k := range sortedKeys(m.Extra) {
+		field(k, m.Extra[k])
+	}
+	for _, e := range m.Entries {
+		buf.WriteString("\n")
+		field("Name", e.Name)
+		field("Content-Type", e.ContentType)
+		field("Algorithm", e.Algorithm)
+		field("Hash", e.Hash)
+		for _, k := range sortedKeys(e.Extra) {
+			field(k, e.Extra[k])
+		}
+	}
+	return buf.Bytes()
+}
+
+// csarWriter collects the files of a CSAR
+type csarWriter struct {
+	root      string            // the directory of the service template
+	paths     map[string]string // the path in the archive of each file, by absolute path
+	taken     map[string]bool   // the paths in the archive already used
+	contents  map[string][]byte // the content of each file, by path in the archive
+	documents map[string]bool   // the paths in the archive of the TOSCA documents
+}
+
+// archivePath returns the path in the archive of the file at abs
+func (cw *csarWriter) archivePath(abs string) string {
+	if p, ok := cw.paths[abs]; ok {
+		return p
+	}
+	p := ""
+	if rel, err := filepath.Rel(cw.root, abs); err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		p = path.Join(csarDefinitionsDir, filepath.ToSlash(rel))
+	}
+	for i := 1; p == "" || cw.taken[p]; i++ {
+		// outside of the directory of the service template, or colliding with such a file
+		p = path.Join(csarDefinitionsDir, "external", filepath.Base(abs))
+		if i > 1 {
+			p = path.Join(csarDefinitionsDir, "external", fmt.Sprint(i), filepath.Base(abs))
+		}
+	}
+	cw.paths[abs] = p
+	cw.taken[p] = true
+	return p
+}
+
+// add packages the file designated by ref and returns the reference to use in the documents of
+// the archive, relative to the Definitions directory. Remote files are not packaged.
+func (cw *csarWriter) add(ref string, document bool) (string, error) {
+	if u, err := url.Parse(ref); err == nil && len(u.Scheme) > 1 {
+		return ref, nil
+	}
+	// the references are relative to the service template, as when parsing it
+	abs := filepath.FromSlash(ref)
+	if !filepath.IsAbs(abs) {
+		abs = filepath.Join(cw.root, abs)
+	}
+	_, known := cw.paths[abs]
+	p := cw.archivePath(abs)
+
+	if !known {
+		var err error
+		if document {
+			err = cw.addDocument(abs)
+		} else {
+			cw.contents[p], err = ioutil.ReadFile(abs)
+		}
+		if err != nil {
+			delete(cw.paths, abs)
+			delete(cw.taken, p)
+			delete(cw.contents, p)
+			return "", err
+		}
+	}
+	return strings.TrimPrefix(p, csarDefinitionsDir+"/"), nil
+}
+
+// addDocument packages the TOSCA document at abs and the files it refers to
+func (cw *csarWriter) addDocument(abs string) error {
+	p := cw.paths[abs]
+	data, err := ioutil.ReadFile(abs)
+	if err != nil {
+		return err
+	}
+	cw.documents[p] = true
+	cw.contents[p] = data
+
+	var root yaml3.Node
+	if err = yaml3.Unmarshal(data, &root); err != nil {
+		return &ParseError{Location: SourceLocation{File: abs}, Err: err}
+	}
+	if len(root.Content) == 0 {
+		return nil
+	}
+	changed, err := cw.references(root.Content[0], "")
+	if err != nil {
+		return &ParseError{Location: SourceLocation{File: abs}, Err: err}
+	}
+	if changed {
+		// the document refers to files moved in the archive
+		var buf bytes.Buffer
+		enc := yaml3.NewEncoder(&buf)
+		enc.SetIndent(2)
+		if err = enc.Encode(&root); err != nil {
+			return err
+		}
+		cw.contents[p] = buf.Bytes()
+	}
+	return nil
+}
+
+// references packages the files referred to by the YAML node n, the value of key, and rewrites
+// the references when the files are moved. It reports if a reference was rewritten.
+func (cw *csarWriter) references(n *yaml3.Node, key string) (bool, error) {
+	switch key {
+	case "imports":
+		return cw.eachReference(n, "file", true)
+	case "artifacts":
+		if n.Kind != yaml3.MappingNode {
+			return false, nil
+		}
+		changed := false
+		for i := 1; i < len(n.Content); i += 2 {
+			c, err := cw.reference(n.Content[i], "file", false, false)
+			if err != nil {
+				return false, err
+			}
+			changed = changed || c
+		}
+		return changed, nil
+	case "interfaces":
+		return cw.operations(n)
+	case "implementation":
+		if n.Kind != yaml3.MappingNode {
+			return cw.reference(n, "file", false, true)
+		}
+		changed := false
+		for i := 0; i+1 < len(n.Content); i += 2 {
+			var c bool
+			var err error
+			switch n.Content[i].Value {
+			case "primary":
+				c, err = cw.reference(n.Content[i+1], "file", false, true)
+			case "dependencies":
+				c, err = cw.eachReference(n.Content[i+1], "file", false)
+			}
+			if err != nil {
+				return false, err
+			}
+			changed = changed || c
+		}
+		return changed, nil
+	}
+
+	changed := false
+	for i := range n.Content {
+		k := ""
+		if n.Kind == yaml3.MappingNode {
+			if i%2 == 0 {
+				continue
+			}
+			k = n.Content[i-1].Value
+		}
+		c, err := cw.references(n.Content[i], k)
+		if err != nil {
+			return false, err
+		}
+		changed = changed || c
+	}
+	return changed, nil
+}
+
+// operations handles the implementations of the operations of interfaces, an operation being
+// written as its implementation or as a map holding it.
+func (cw *csarWriter) operations(n *yaml3.Node) (bool, error) {
+	if n.Kind != yaml3.MappingNode {
+		return false, nil
+	}
+	changed := false
+	for i := 1; i < len(n.Content); i += 2 {
+		itf := n.Content[i]
+		if itf.Kind != yaml3.MappingNode {
+			continue
+		}
+		for j := 0; j+1 < len(itf.Content); j += 2 {
+			var c bool
+			var err error
+			switch op := itf.Content[j+1]; itf.Content[j].Value {
+			case "type", "description", "inputs", "derived_from":
+			case "operations", "notifications":
+				c, err = cw.operations(&yaml3.Node{Kind: yaml3.MappingNode, Content: []*yaml3.Node{itf.Content[j], op}})
+			default:
+				if op.Kind == yaml3.ScalarNode {
+					c, err = cw.reference(op, "file", false, true)
+				} else {
+					c, err = cw.references(op, "")
+				}
+			}
+			if err != nil {
+				return false, err
+			}
+			changed = changed || c
+		}
+	}
+	return changed, nil
+}
+
+// eachReference handles the references of a list, or of a map of named references
+func (cw *csarWriter) eachReference(n *yaml3.Node, fileKey string, document bool) (bool, error) {
+	changed := false
+	for i, c := range n.Content {
+		if n.Kind == yaml3.MappingNode && i%2 == 0 {
+			continue
+		}
+		if document && c.Kind == yaml3.MappingNode && len(c.Content) == 2 && c.Content[0].Value == "profile" {
+			// a registered profile, not a file
+			continue
+		}
+		if n.Kind == yaml3.SequenceNode && c.Kind == yaml3.MappingNode && len(c.Content) == 2 && c.Content[0].Value != fileKey {
+			// a named reference: - name: path or - name: {file: path}
+			c = c.Content[1]
+		}
+		rewritten, err := cw.reference(c, fileKey, document, false)
+		if err != nil {
+			return false, err
+		}
+		changed = changed || rewritten
+	}
+	return changed, nil
+}
+
+// reference handles a reference written as a path or as a map with the path under fileKey.
+// An implementation may name an artifact instead of a file.
+func (cw *csarWriter) reference(n *yaml3.Node, fileKey string, document, implementation bool) (bool, error) {
+	if n.Kind == yaml3.MappingNode {
+		for i := 0; i+1 < len(n.Content); i += 2 {
+			if n.Content[i].Value == "repository" || (document && n.Content[i].Value == "profile") {
+				// retrieved from the repository, or the profile registry, when used
+				return false, nil
+			}
+		}
+		for i := 0; i+1 < len(n.Content); i += 2 {
+			if n.Content[i].Value == fileKey {
+				n = n.Content[i+1]
+				break
+			}
+		}
+	}
+	if n.Kind != yaml3.ScalarNode || n.Value == "" {
+		return false, nil
+	}
+
+	ref, err := cw.add(n.Value, document)
+	if err != nil {
+		if implementation && errors.Is(err, os.ErrNotExist) && !strings.ContainsAny(n.Value, "./\\") {
+			// the name of an artifact of the node
+			return false, nil
+		}
+		return false, err
+	}
+	if ref == filepath.ToSlash(filepath.Clean(n.Value)) || ref == n.Value {
+		return false, nil
+	}
+	n.Value = ref
+	return true, nil
+}
+
+// contentTypes lists the Content-Type of the usual artifacts, the others are looked up with
+// the mime package.
+var contentTypes = map[string]string{
+	".sh":   "application/x-sh",
+	".py":   "text/x-python",
+	".yaml": "application/x-yaml",
+	".yml":  "application/x-yaml",
+	".json": "application/json",
+	".cert": "application/x-pem-file",
+}
+
+// contentType returns the Content-Type of a file of the archive
+func (cw *csarWriter) contentType(name string) string {
+	if cw.documents[name] {
+		return toscaContentType
+	}
+	if t, ok := contentTypes[path.Ext(name)]; ok {
+		return t
+	}
+	if t := mime.TypeByExtension(path.Ext(name)); t != "" {
+		return t
+	}
+	return "application/octet-stream"
+}
@@ -0,0 +1,152 @@
+package toscalib
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Errors returned, wrapped in an EvaluationError, when a function can not be evaluated
+var (
+	ErrInvalidArguments        = errors.New("invalid function arguments")
+	ErrUnknownFunction         = errors.New("unknown function")
+	ErrNodeNotFound            = errors.New("node template not found")
+	ErrPropertyNotFound        = errors.New("property not found")
+	ErrAttributeNotFound       = errors.New("attribute not found")
+	ErrInputNotFound           = errors.New("input not found")
+	ErrArtifactNotFound        = errors.New("artifact not found")
+	ErrOperationOutputNotFound = errors.New("operation output not found")
+	ErrIndexOutOfRange         = errors.New("index out of range")
+	ErrKeyNotFound             = errors.New("key not found")
+	ErrEvaluationCycle         = errors.New("evaluation cycle")
+)
+
+// EvaluationError describes which function failed to evaluate and why
+type EvaluationError struct {
+	Function string
+	Args     []interface{}
+	Err      error
+}
+
+func (e *EvaluationError) Error() string {
+	return fmt.Sprintf("%s: %v", formatCall(e.Function, e.Args), e.Err)
+}
+
+// Unwrap returns the underlying error
+func (e *EvaluationError) Unwrap() error {
+	return e.Err
+}
+
+// CycleError is returned when a function references, directly or not, the value it is
+// evaluating. Chain lists the references followed, ending with the repeated one.
+type CycleError struct {
+	Chain []string
+}
+
+func (e *CycleError) Error() string {
+	return fmt.Sprintf("%v: %s", ErrEvaluationCycle, strings.Join(e.Chain, " -> "))
+}
+
+// Unwrap returns ErrEvaluationCycle
+func (e *CycleError) Unwrap() error {
+	return ErrEvaluationCycle
+}
+
+// EvaluationTrace records each hop taken while evaluating an Assignment, for example
+// get_property[SELF, host, num_cpus] -> node server -> capability host -> 4
+type EvaluationTrace struct {
+	Hops []string
+}
+
+func (t *EvaluationTrace) add(format string, a ...interface{}) {
+	if t != nil {
+		t.Hops = append(t.Hops, fmt.Sprintf(format, a...))
+	}
+}
+
+func (t *EvaluationTrace) String() string {
+	return strings.Join(t.Hops, " -> ")
+}
+
+func formatCall(function string, args []interface{}) string {
+	parts := make([]string, len(args))
+	for i, a := range args {
+		parts[i] = fmt.Sprint(a)
+	}
+	return fmt.Sprintf("%s[%s]", function, strings.Join(parts, ", "))
+}
+
+// evaluator holds the state of a single evaluation
+type evaluator struct {
+	std      *ServiceTemplateDefinition
+	trace    *EvaluationTrace
+	workflow string
+	stack    []string        // references being evaluated, used to detect cycles
+	context  context.Context // cancels the retrieval of the repository files, nil when none is set
+}
+
+// enter records that the value designated by ref is being evaluated, it fails if ref is
+// already being evaluated.
+func (e *evaluator) enter(ref string) error {
+	for _, r := range e.stack {
+		if r == ref {
+			chain := make([]string, len(e.stack), len(e.stack)+1)
+			copy(chain, e.stack)
+			return &CycleError{Chain: append(chain, ref)}
+		}
+	}
+	e.stack = append(e.stack, ref)
+	return nil
+}
+
+func (e *evaluator) leave() {
+	e.stack = e.stack[:len(e.stack)-1]
+}
+
+// retrievalContext returns the context cancelling the retrieval of the repository files, the
+// background context when none is set
+func (e *evaluator) retrievalContext() context.Context {
+	if e.context == nil {
+		return context.Background()
+	}
+	return e.context
+}
+
+// EvaluateE gets the value of an Assignment, including the evaluation of expression or function,
+// and returns an *EvaluationError when a function can not be evaluated.
+func (p *Assignment) EvaluateE(std *ServiceTemplateDefinition, ctx string) (interface{}, error) {
+	return p.EvaluateTrace(std, ctx, nil)
+}
+
+// EvaluateTrace behaves like EvaluateE and records each hop of the evaluation in trace,
+// which can be nil.
+func (p *Assignment) EvaluateTrace(std *ServiceTemplateDefinition, ctx string, trace *EvaluationTrace) (interface{}, error) {
+	e := &evaluator{std: std, trace: trace}
+	v, err := e.eval(p, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// EvaluateContext behaves like EvaluateE, get_artifact stops retrieving the artifacts stored in
+// repositories when ctx is done.
+func (p *Assignment) EvaluateContext(ctx context.Context, std *ServiceTemplateDefinition, name string) (interface{}, error) {
+	e := &evaluator{std: std, context: ctx}
+	v, err := e.eval(p, name)
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// EvaluateForWorkflowE behaves like EvaluateE, get_input looks up the workflow inputs first
+func (p *Assignment) EvaluateForWorkflowE(std *ServiceTemplateDefinition, wfname string) (interface{}, error) {
+	e := &evaluator{std: std, workflow: wfname}
+	v, err := e.eval(p, wfname)
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
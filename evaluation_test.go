@@ -0,0 +1,139 @@
+package toscalib
+
+import (
+	"errors"
+	"os"
+	"reflect"
+	"testing"
+)
+
+func TestEvaluateTrace(t *testing.T) {
+	fname := "./tests/tosca_helloworld.yaml"
+	var s ServiceTemplateDefinition
+	o, err := os.Open(fname)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = s.Parse(o)
+	if err != nil {
+		t.Log("Error in processing", fname)
+		t.Fatal(err)
+	}
+
+	pa := Assignment{Function: GetPropFunc, Args: []interface{}{Self, "host", "num_cpus"}}
+	var trace EvaluationTrace
+	v, err := pa.EvaluateTrace(&s, "my_server", &trace)
+	if err != nil || v != "2" {
+		t.Log(fname, "evaluation of `num_cpus` failed", v, err)
+		t.Fail()
+	}
+	expected := "get_property[SELF, host, num_cpus] -> node my_server -> capability host -> 2"
+	if trace.String() != expected {
+		t.Errorf("%s trace expected %q, actual %q", fname, expected, trace.String())
+	}
+}
+
+func TestEvaluateE(t *testing.T) {
+	fname := "./tests/tosca_helloworld.yaml"
+	var s ServiceTemplateDefinition
+	o, err := os.Open(fname)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = s.Parse(o)
+	if err != nil {
+		t.Log("Error in processing", fname)
+		t.Fatal(err)
+	}
+	s.SetAttribute("my_server", "addresses", []string{"10.0.0.1"})
+
+	testCases := []struct {
+		name     string
+		pa       Assignment
+		expected error
+	}{
+		{"missing node", Assignment{Function: GetPropFunc, Args: []interface{}{"no_server", "num_cpus"}}, ErrNodeNotFound},
+		{"unknown capability", Assignment{Function: GetPropFunc, Args: []interface{}{Self, "no_cap", "num_cpus"}}, ErrPropertyNotFound},
+		{"missing attribute", Assignment{Function: GetAttrFunc, Args: []interface{}{Self, "no_attr"}}, ErrAttributeNotFound},
+		{"bad index", Assignment{Function: GetAttrFunc, Args: []interface{}{Self, "addresses", "1"}}, ErrIndexOutOfRange},
+		{"missing input", Assignment{Function: ConcatFunc, Args: []interface{}{"http://", map[interface{}]interface{}{GetInputFunc: "host"}}}, ErrInputNotFound},
+		{"token out of range", Assignment{Function: TokenFunc, Args: []interface{}{"a.b", ".", 5}}, ErrIndexOutOfRange},
+		{"missing artifact", Assignment{Function: GetArtifactFunc, Args: []interface{}{Self, "no_artifact"}}, ErrArtifactNotFound},
+		{"arguments", Assignment{Function: GetInputFunc}, ErrInvalidArguments},
+	}
+
+	for _, tc := range testCases {
+		v, err := tc.pa.EvaluateE(&s, "my_server")
+		if !errors.Is(err, tc.expected) {
+			t.Errorf("%s: expected %v, actual %v", tc.name, tc.expected, err)
+			continue
+		}
+		var ee *EvaluationError
+		if !errors.As(err, &ee) || ee.Function != tc.pa.Function {
+			t.Errorf("%s: expected an EvaluationError for %s, actual %v", tc.name, tc.pa.Function, err)
+		}
+		if v != nil {
+			t.Errorf("%s: expected no value, actual %v", tc.name, v)
+		}
+	}
+
+	// Evaluate keeps returning what could be evaluated
+	pa := testCases[4].pa
+	if v := pa.Evaluate(&s, "my_server"); v != "http://" {
+		t.Log(fname, "concat expected partial value `http://`, actual", v)
+		t.Fail()
+	}
+	pa = Assignment{Function: ConcatFunc, Args: []interface{}{"http://", nil, ":", map[interface{}]interface{}{GetPropFunc: []interface{}{Self, "host", "num_cpus"}}}}
+	if v, err := pa.EvaluateE(&s, "my_server"); err != nil || v != "http://:2" {
+		t.Errorf("%s: concat expected the null arguments empty, actual %v %v", fname, v, err)
+	}
+
+	pa = Assignment{Function: GetAttrFunc, Args: []interface{}{Self, "addresses", "0"}}
+	if v, err := pa.EvaluateE(&s, "my_server"); err != nil || v != "10.0.0.1" {
+		t.Log(fname, "indexed attribute evaluation failed", v, err)
+		t.Fail()
+	}
+}
+
+func TestEvaluateCycles(t *testing.T) {
+	fname := "./tests/evaluation_cycles.yaml"
+	var s ServiceTemplateDefinition
+	o, err := os.Open(fname)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = s.Parse(o)
+	if err != nil {
+		t.Log("Error in processing", fname)
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		ctx   string
+		pa    *PropertyAssignment
+		chain []string
+	}{
+		{"app_a", s.GetProperty("app_a", "url"), []string{"node app_b property url", "node app_a property url", "node app_b property url"}},
+		{"app_c", s.GetProperty("app_c", "name"), []string{"node app_c attribute name", "node app_c attribute name"}},
+		// nested function within a list value
+		{"app_c", &PropertyAssignment{Assignment{Function: GetPropFunc, Args: []interface{}{Self, "list", "1"}}}, []string{"node app_c property list", "node app_c property list"}},
+	}
+
+	for _, tc := range testCases {
+		_, err := tc.pa.EvaluateE(&s, tc.ctx)
+		var ce *CycleError
+		if !errors.Is(err, ErrEvaluationCycle) || !errors.As(err, &ce) {
+			t.Errorf("%v: expected a cycle error, actual %v", tc.pa.Args, err)
+			continue
+		}
+		if !reflect.DeepEqual(ce.Chain, tc.chain) {
+			t.Errorf("%v: expected chain %v, actual %v", tc.pa.Args, tc.chain, ce.Chain)
+		}
+	}
+
+	// a value referenced twice without recursion is not a cycle
+	pa := s.GetProperty("app_c", "ok")
+	if v, err := pa.EvaluateE(&s, "app_c"); err != nil || v != "first-first" {
+		t.Errorf("app_c.ok: expected `first-first`, actual %v %v", v, err)
+	}
+}
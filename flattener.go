@@ -4,6 +4,7 @@ import "github.com/kenjones-cisco/mergo"
 
 type flatTypes struct {
 	ArtifactTypes map[string]ArtifactType
+	DataTypes     map[string]DataType
 	Capabilities  map[string]CapabilityType
 	Interfaces    map[string]InterfaceType
 	Relationships map[string]RelationshipType
@@ -39,6 +40,72 @@ func flattenArtType(name string, s ServiceTemplateDefinition) ArtifactType {
 	return ArtifactType{}
 }
 
+func flattenDataType(name string, s ServiceTemplateDefinition) DataType {
+	if dt, ok := s.DataTypes[name]; ok {
+		if dt.DerivedFrom != "" {
+			parent := flattenDataType(dt.DerivedFrom, s)
+
+			// clone the parent first before applying any changes
+			tmp := clone(parent)
+			dtm, _ := tmp.(DataType)
+
+			// mergo does not handle merging Slices so the items
+			// will wipe away, capture the values here.
+			constraints := dtm.Constraints
+			props := make(map[string]PropertyDefinition, len(dtm.Properties))
+			for k, pd := range dtm.Properties {
+				props[k] = pd
+			}
+
+			_ = mergo.MergeWithOverwrite(&dtm, dt)
+
+			// now copy them back in using append, if the child type had
+			// any previously, otherwise it will duplicate the parents.
+			if len(dt.Constraints) > 0 {
+				dtm.Constraints = append(dtm.Constraints, constraints...)
+			}
+
+			// a property redefined by the child refines the one of the parent,
+			// the constraints of both apply.
+			for k, pd := range dt.Properties {
+				if ppd, ok := props[k]; ok {
+					pconstraints := ppd.Constraints
+					_ = mergo.MergeWithOverwrite(&ppd, pd)
+					if len(pd.Constraints) > 0 {
+						ppd.Constraints = append(ppd.Constraints, pconstraints...)
+					}
+					// false is not merged, required is always set when parsed
+					ppd.Required = pd.Required
+					dtm.Properties[k] = ppd
+				}
+			}
+			return dtm
+		}
+		return dt
+	}
+	return DataType{}
+}
+
+// dataTypeCycle returns the loop of data types deriving from one another the derivation of name
+// ends in, starting and ending with the same data type, or nil when the derivation does not loop
+func dataTypeCycle(name string, s ServiceTemplateDefinition) []string {
+	var chain []string
+	seen := make(map[string]int)
+	for name != "" {
+		if i, ok := seen[name]; ok {
+			return append(chain[i:], name)
+		}
+		dt, ok := s.DataTypes[name]
+		if !ok {
+			return nil
+		}
+		seen[name] = len(chain)
+		chain = append(chain, name)
+		name = dt.DerivedFrom
+	}
+	return nil
+}
+
 func flattenCapType(name string, s ServiceTemplateDefinition) CapabilityType {
 	if ct, ok := s.CapabilityTypes[name]; ok {
 		if ct.DerivedFrom != "" {
@@ -195,30 +262,59 @@ func flattenPolicyType(name string, s ServiceTemplateDefinition) PolicyType {
 	return PolicyType{}
 }
 
-func flattenHierarchy(s ServiceTemplateDefinition) flatTypes {
+// flattenHierarchy flattens the types of s, the types of base are already flattened
+func flattenHierarchy(s ServiceTemplateDefinition, base flatTypes) flatTypes {
 	var flats flatTypes
 
 	flats.ArtifactTypes = make(map[string]ArtifactType)
 	for name := range s.ArtifactTypes {
+		if at, ok := base.ArtifactTypes[name]; ok {
+			flats.ArtifactTypes[name] = at
+			continue
+		}
 		flats.ArtifactTypes[name] = flattenArtType(name, s)
 	}
 
+	flats.DataTypes = make(map[string]DataType)
+	for name := range s.DataTypes {
+		if dt, ok := base.DataTypes[name]; ok {
+			flats.DataTypes[name] = dt
+			continue
+		}
+		flats.DataTypes[name] = flattenDataType(name, s)
+	}
+
 	flats.Capabilities = make(map[string]CapabilityType)
 	for name := range s.CapabilityTypes {
+		if ct, ok := base.Capabilities[name]; ok {
+			flats.Capabilities[name] = ct
+			continue
+		}
 		flats.Capabilities[name] = flattenCapType(name, s)
 	}
 
 	flats.Interfaces = make(map[string]InterfaceType)
 	for name := range s.InterfaceTypes {
+		if it, ok := base.Interfaces[name]; ok {
+			flats.Interfaces[name] = it
+			continue
+		}
 		flats.Interfaces[name] = flattenIntfType(name, s)
 	}
 
 	flats.Relationships = make(map[string]RelationshipType)
 	for name := range s.RelationshipTypes {
+		if rt, ok := base.Relationships[name]; ok {
+			flats.Relationships[name] = rt
+			continue
+		}
 		flats.Relationships[name] = flattenRelType(name, s)
 	}
 
 	for k, v := range flats.Relationships {
+		if _, ok := base.Relationships[k]; ok {
+			continue
+		}
 		for name, iDef := range v.Interfaces {
 			// during merge the Type is not always properly inherited, so set it
 			// from the parent.
@@ -233,10 +329,17 @@ func flattenHierarchy(s ServiceTemplateDefinition) flatTypes {
 
 	flats.Nodes = make(map[string]NodeType)
 	for name := range s.NodeTypes {
+		if nt, ok := base.Nodes[name]; ok {
+			flats.Nodes[name] = nt
+			continue
+		}
 		flats.Nodes[name] = flattenNodeType(name, s)
 	}
 
 	for k, v := range flats.Nodes {
+		if _, ok := base.Nodes[k]; ok {
+			continue
+		}
 		for name, capDef := range v.Capabilities {
 			capDef.extendFrom(flats.Capabilities[capDef.Type])
 			v.Capabilities[name] = capDef
@@ -255,11 +358,19 @@ func flattenHierarchy(s ServiceTemplateDefinition) flatTypes {
 
 	flats.Groups = make(map[string]GroupType)
 	for name := range s.GroupTypes {
+		if gt, ok := base.Groups[name]; ok {
+			flats.Groups[name] = gt
+			continue
+		}
 		flats.Groups[name] = flattenGroupType(name, s)
 	}
 
 	flats.Policies = make(map[string]PolicyType)
 	for name := range s.PolicyTypes {
+		if pt, ok := base.Policies[name]; ok {
+			flats.Policies[name] = pt
+			continue
+		}
 		flats.Policies[name] = flattenPolicyType(name, s)
 	}
 
@@ -24,6 +24,48 @@ type GroupDefinition struct {
 	Metadata    Metadata                       `yaml:"metadata,omitempty" json:"metadata"`
 	Description string                         `yaml:"description,omitempty" json:"description"`
 	Properties  map[string]PropertyAssignment  `yaml:"properties,omitempty" json:"properties"`
+	Attributes  map[string]AttributeAssignment `yaml:"attributes,omitempty" json:"attributes,omitempty"`
 	Interfaces  map[string]InterfaceDefinition `yaml:"interfaces,omitempty" json:"interfaces"`
 	Members     []string                       `yaml:"members,omitempty" json:"members,omitempty"`
+	Refs        struct {
+		Type GroupType `yaml:"-" json:"-"`
+	} `yaml:"-" json:"-"`
+}
+
+func (g *GroupType) reflectProperties() {
+	tmp := reflectDefinitionProps(g.Properties, g.Attributes)
+	g.Attributes = *tmp
+}
+
+func (g *GroupDefinition) reflectProperties() {
+	tmp := reflectAssignmentProps(g.Properties, g.Attributes)
+	g.Attributes = *tmp
+}
+
+func (g *GroupDefinition) extendFrom(gt GroupType) {
+	g.Refs.Type = gt
+
+	for k, v := range gt.Interfaces {
+		if len(g.Interfaces) == 0 {
+			g.Interfaces = make(map[string]InterfaceDefinition)
+		}
+		if intf, ok := g.Interfaces[k]; ok {
+			intf.merge(v)
+			g.Interfaces[k] = intf
+		} else {
+			g.Interfaces[k] = v
+		}
+	}
+
+	for k, v := range gt.Properties {
+		if len(g.Properties) == 0 {
+			g.Properties = make(map[string]PropertyAssignment)
+		}
+		if _, ok := g.Properties[k]; !ok {
+			tmp := newPA(v)
+			g.Properties[k] = *tmp
+		}
+	}
+
+	g.reflectProperties()
 }
@@ -19,12 +19,52 @@ package toscalib
 // InterfaceType as described in Appendix A 6.4
 // An Interface Type is a reusable entity that describes a set of operations that can be used to interact with or manage a node or relationship in a TOSCA topology.
 type InterfaceType struct {
-	DerivedFrom string                         `yaml:"derived_from,omitempty" json:"derived_from"`
-	Version     Version                        `yaml:"version,omitempty"`
-	Metadata    Metadata                       `yaml:"metadata,omitempty" json:"metadata"`
-	Description string                         `yaml:"description,omitempty"`
-	Inputs      map[string]PropertyDefinition  `yaml:"inputs,omitempty" json:"inputs"` // The optional list of input parameter definitions.
-	Operations  map[string]OperationDefinition `yaml:"operations,inline"`
+	DerivedFrom   string                         `yaml:"derived_from,omitempty" json:"derived_from"`
+	Version       Version                        `yaml:"version,omitempty"`
+	Metadata      Metadata                       `yaml:"metadata,omitempty" json:"metadata"`
+	Description   string                         `yaml:"description,omitempty"`
+	Inputs        map[string]PropertyDefinition  `yaml:"inputs,omitempty" json:"inputs"`                         // The optional list of input parameter definitions.
+	Operations    map[string]OperationDefinition `yaml:"operations,inline"`                                      // Written inline, or under the operations keyname since tosca_simple_yaml_1_3.
+	Notifications map[string]OperationDefinition `yaml:"notifications,omitempty" json:"notifications,omitempty"` // The notifications sent by the implementations, since tosca_simple_yaml_1_3.
+}
+
+// UnmarshalYAML converts YAML text to a type
+func (i *InterfaceType) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var str struct {
+		DerivedFrom   string                         `yaml:"derived_from,omitempty"`
+		Version       Version                        `yaml:"version,omitempty"`
+		Metadata      Metadata                       `yaml:"metadata,omitempty"`
+		Description   string                         `yaml:"description,omitempty"`
+		Inputs        map[string]PropertyDefinition  `yaml:"inputs,omitempty"`
+		Grouped       map[string]OperationDefinition `yaml:"operations,omitempty"`
+		Notifications map[string]OperationDefinition `yaml:"notifications,omitempty"`
+		Operations    map[string]OperationDefinition `yaml:",inline"`
+	}
+	if err := unmarshal(&str); err != nil {
+		return err
+	}
+	i.DerivedFrom = str.DerivedFrom
+	i.Version = str.Version
+	i.Metadata = str.Metadata
+	i.Description = str.Description
+	i.Inputs = str.Inputs
+	i.Operations = groupOperations(str.Operations, str.Grouped)
+	i.Notifications = str.Notifications
+	return nil
+}
+
+// groupOperations adds the operations listed under the operations keyname to the inline ones
+func groupOperations(inline, grouped map[string]OperationDefinition) map[string]OperationDefinition {
+	if len(grouped) == 0 {
+		return inline
+	}
+	if inline == nil {
+		inline = make(map[string]OperationDefinition)
+	}
+	for k, v := range grouped {
+		inline[k] = v
+	}
+	return inline
 }
 
 // OperationDefinition defines a named function or procedure that can be bound to an implementation artifact (e.g., a script).
@@ -57,9 +97,29 @@ func (i *OperationDefinition) UnmarshalYAML(unmarshal func(interface{}) error) e
 
 // InterfaceDefinition is related to a node type
 type InterfaceDefinition struct {
-	Type       string                         `yaml:"type" json:"type"`
-	Inputs     map[string]PropertyAssignment  `yaml:"inputs,omitempty"`
-	Operations map[string]OperationDefinition `yaml:"operations,inline"`
+	Type          string                         `yaml:"type" json:"type"`
+	Inputs        map[string]PropertyAssignment  `yaml:"inputs,omitempty"`
+	Operations    map[string]OperationDefinition `yaml:"operations,inline"`                                      // Written inline, or under the operations keyname since tosca_simple_yaml_1_3.
+	Notifications map[string]OperationDefinition `yaml:"notifications,omitempty" json:"notifications,omitempty"` // Since tosca_simple_yaml_1_3.
+}
+
+// UnmarshalYAML converts YAML text to a type
+func (i *InterfaceDefinition) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var str struct {
+		Type          string                         `yaml:"type"`
+		Inputs        map[string]PropertyAssignment  `yaml:"inputs,omitempty"`
+		Grouped       map[string]OperationDefinition `yaml:"operations,omitempty"`
+		Notifications map[string]OperationDefinition `yaml:"notifications,omitempty"`
+		Operations    map[string]OperationDefinition `yaml:",inline"`
+	}
+	if err := unmarshal(&str); err != nil {
+		return err
+	}
+	i.Type = str.Type
+	i.Inputs = str.Inputs
+	i.Operations = groupOperations(str.Operations, str.Grouped)
+	i.Notifications = str.Notifications
+	return nil
 }
 
 func (i *InterfaceDefinition) extendFrom(intfType InterfaceType) {
@@ -99,6 +159,7 @@ func (i *InterfaceDefinition) extendFrom(intfType InterfaceType) {
 			i.Operations[k] = v
 		}
 	}
+	i.Notifications = mergeNotifications(i.Notifications, intfType.Notifications)
 }
 
 func (i *InterfaceDefinition) merge(other InterfaceDefinition) {
@@ -140,4 +201,18 @@ func (i *InterfaceDefinition) merge(other InterfaceDefinition) {
 			i.Operations[k] = v
 		}
 	}
+	i.Notifications = mergeNotifications(i.Notifications, other.Notifications)
+}
+
+// mergeNotifications adds the notifications of other missing from notifications
+func mergeNotifications(notifications, other map[string]OperationDefinition) map[string]OperationDefinition {
+	for k, v := range other {
+		if notifications == nil {
+			notifications = make(map[string]OperationDefinition)
+		}
+		if _, ok := notifications[k]; !ok {
+			notifications[k] = v
+		}
+	}
+	return notifications
 }
@@ -0,0 +1,214 @@
+package toscalib
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+	yaml3 "gopkg.in/yaml.v3"
+)
+
+// SourceLocation identifies where an element was defined. File is the location of the
+// document as provided to the Resolver (or the normative asset name), Archive is set to
+// the CSAR file when the document was read from an archive.
+type SourceLocation struct {
+	Archive string `yaml:"archive,omitempty" json:"archive,omitempty"`
+	File    string `yaml:"file,omitempty" json:"file,omitempty"`
+	Line    int    `yaml:"line,omitempty" json:"line,omitempty"`
+	Column  int    `yaml:"column,omitempty" json:"column,omitempty"`
+}
+
+// IsZero returns true if no location information is available
+func (l SourceLocation) IsZero() bool {
+	return l == SourceLocation{}
+}
+
+func (l SourceLocation) String() string {
+	file := l.File
+	if file == "" {
+		file = "<input>"
+	}
+	if l.Archive != "" {
+		file = l.Archive + "!" + file
+	}
+	if l.Line == 0 {
+		return file
+	}
+	if l.Column == 0 {
+		return fmt.Sprintf("%s:%d", file, l.Line)
+	}
+	return fmt.Sprintf("%s:%d:%d", file, l.Line, l.Column)
+}
+
+// ParseError is returned when a document, or one of its imports, could not be loaded.
+// Path is the dotted location of the offending element within the document when known.
+type ParseError struct {
+	Location SourceLocation
+	Path     string
+	Err      error
+}
+
+func (e *ParseError) Error() string {
+	if e.Path != "" {
+		return fmt.Sprintf("%s: %s: %v", e.Location, e.Path, e.Err)
+	}
+	return fmt.Sprintf("%s: %v", e.Location, e.Err)
+}
+
+// Unwrap returns the underlying error
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// Location returns where the element identified by path was defined. The path uses the
+// same notation as Diagnostic, for example node_types.tosca.nodes.Compute or
+// topology_template.node_templates.web.requirements[0].host
+func (s *ServiceTemplateDefinition) Location(path string) (SourceLocation, bool) {
+	loc, ok := s.Locations[path]
+	return loc, ok
+}
+
+// locate returns the location of path or of its closest known parent.
+func (s *ServiceTemplateDefinition) locate(path string) SourceLocation {
+	for path != "" {
+		if loc, ok := s.Locations[path]; ok {
+			return loc
+		}
+		i := strings.LastIndexAny(path, ".[")
+		if i < 0 {
+			break
+		}
+		path = path[:i]
+	}
+	return SourceLocation{}
+}
+
+var lineRegexp = regexp.MustCompile(`line (\d+)`)
+
+// decodeSTD unmarshals a document and records the location of each of its elements.
+// Errors are returned as *ParseError pointing at the offending element. The document is
+// decoded with the YAML 1.1 rules of yaml.v2, the node tree of yaml.v3 only provides the
+// locations.
+func decodeSTD(loc SourceLocation, data []byte) (ServiceTemplateDefinition, error) {
+	var std ServiceTemplateDefinition
+
+	var root yaml3.Node
+	if err := yaml3.Unmarshal(data, &root); err != nil {
+		return std, &ParseError{Location: errorLocation(loc, err), Err: err}
+	}
+
+	if err := yaml.Unmarshal(data, &std); err != nil {
+		pe := &ParseError{Location: errorLocation(loc, err), Err: err}
+		if len(root.Content) != 0 {
+			if pe.Path = failingPath(root.Content[0], reflect.TypeOf(std), ""); pe.Path != "" {
+				locs := make(map[string]SourceLocation)
+				indexLocations(loc, root.Content[0], "", locs)
+				pe.Location = locs[pe.Path]
+			}
+		}
+		return std, pe
+	}
+
+	if len(root.Content) != 0 {
+		std.Locations = make(map[string]SourceLocation)
+		indexLocations(loc, root.Content[0], "", std.Locations)
+	}
+	return std, nil
+}
+
+// errorLocation extracts the line reported by the yaml package, if any.
+func errorLocation(loc SourceLocation, err error) SourceLocation {
+	if m := lineRegexp.FindStringSubmatch(err.Error()); m != nil {
+		loc.Line, _ = strconv.Atoi(m[1])
+	}
+	return loc
+}
+
+// indexLocations records the position of every mapping key and sequence item below node.
+func indexLocations(loc SourceLocation, node *yaml3.Node, path string, locs map[string]SourceLocation) {
+	switch node.Kind {
+	case yaml3.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key := node.Content[i]
+			if key.Kind != yaml3.ScalarNode {
+				continue
+			}
+			p := key.Value
+			if path != "" {
+				p = path + "." + key.Value
+			}
+			l := loc
+			l.Line, l.Column = key.Line, key.Column
+			locs[p] = l
+			indexLocations(loc, node.Content[i+1], p, locs)
+		}
+	case yaml3.SequenceNode:
+		for i, item := range node.Content {
+			p := fmt.Sprintf("%s[%d]", path, i)
+			l := loc
+			l.Line, l.Column = item.Line, item.Column
+			locs[p] = l
+			indexLocations(loc, item, p, locs)
+		}
+	}
+}
+
+// failingPath narrows down which element of node can not be unmarshalled into typ, by
+// decoding each child on its own against the matching field, map or slice element type.
+func failingPath(node *yaml3.Node, typ reflect.Type, path string) string {
+	for typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+
+	join := func(key string) string {
+		if path == "" {
+			return key
+		}
+		return path + "." + key
+	}
+
+	switch {
+	case node.Kind == yaml3.MappingNode && typ.Kind() == reflect.Struct:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, value := node.Content[i].Value, node.Content[i+1]
+			if f, ok := fieldByTag(typ, key); ok && decodeFails(value, f.Type) {
+				return failingPath(value, f.Type, join(key))
+			}
+		}
+	case node.Kind == yaml3.MappingNode && typ.Kind() == reflect.Map:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, value := node.Content[i].Value, node.Content[i+1]
+			if decodeFails(value, typ.Elem()) {
+				return failingPath(value, typ.Elem(), join(key))
+			}
+		}
+	case node.Kind == yaml3.SequenceNode && typ.Kind() == reflect.Slice:
+		for i, item := range node.Content {
+			if decodeFails(item, typ.Elem()) {
+				return failingPath(item, typ.Elem(), fmt.Sprintf("%s[%d]", path, i))
+			}
+		}
+	}
+	return path
+}
+
+func fieldByTag(typ reflect.Type, key string) (reflect.StructField, bool) {
+	for i := 0; i < typ.NumField(); i++ {
+		f := typ.Field(i)
+		if name := strings.Split(f.Tag.Get("yaml"), ",")[0]; name == key {
+			return f, true
+		}
+	}
+	return reflect.StructField{}, false
+}
+
+func decodeFails(node *yaml3.Node, typ reflect.Type) bool {
+	data, err := yaml3.Marshal(node)
+	if err != nil {
+		return false
+	}
+	return yaml.Unmarshal(data, reflect.New(typ).Interface()) != nil
+}
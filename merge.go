@@ -0,0 +1,94 @@
+package toscalib
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+)
+
+// ErrMergeConflict is returned, wrapped in a MergeConflict, when two documents define the
+// same type differently.
+var ErrMergeConflict = errors.New("conflicting type definitions")
+
+// MergeConflict describes a type defined by a document and redefined differently by a document
+// loaded afterwards, for example an import shadowing a normative type. Identical redefinitions
+// are not conflicts.
+type MergeConflict struct {
+	Section string // The section of the type, for example node_types.
+	Name    string
+	First   SourceLocation // Where the type was defined first.
+	Second  SourceLocation // Where the type is redefined, this definition wins when the parsing continues.
+}
+
+func (c *MergeConflict) Error() string {
+	return fmt.Sprintf("%s %s defined at %s is redefined differently at %s", c.Section, c.Name, c.First, c.Second)
+}
+
+// Unwrap returns ErrMergeConflict
+func (c *MergeConflict) Unwrap() error {
+	return ErrMergeConflict
+}
+
+// FailOnConflict is a ParserHooks.Conflict policy which stops the parsing on the first conflict
+func FailOnConflict(c *MergeConflict) error {
+	return c
+}
+
+// WarnOnConflict returns a ParserHooks.Conflict policy which writes each conflict to w and
+// lets the last definition win.
+func WarnOnConflict(w io.Writer) func(c *MergeConflict) error {
+	return func(c *MergeConflict) error {
+		_, err := fmt.Fprintf(w, "warning: %v\n", c)
+		return err
+	}
+}
+
+// typeDefinition is a type as defined by the first document which defined it
+type typeDefinition struct {
+	location SourceLocation
+	value    interface{}
+}
+
+// register records the types defined by a document loaded from source, a type redefined
+// differently is passed to the Conflict hook.
+func (p *parser) register(source string, std *ServiceTemplateDefinition) error {
+	if p.hooks.Conflict == nil {
+		return nil
+	}
+
+	v := reflect.ValueOf(std).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		if !isTypeSection(v.Type().Field(i)) {
+			continue
+		}
+		section := yamlName(v.Type().Field(i))
+		types := v.Field(i)
+		for _, name := range sortedKeys(types.Interface()) {
+			loc := std.locate(section + "." + name)
+			if loc.IsZero() {
+				loc = p.location(source)
+			}
+			value := types.MapIndex(reflect.ValueOf(name)).Interface()
+
+			// the types of a namespace are registered with their qualified name
+			name = p.scope() + name
+			path := section + "." + name
+
+			def, ok := p.types[path]
+			if !ok {
+				p.types[path] = typeDefinition{location: loc, value: value}
+				continue
+			}
+			if reflect.DeepEqual(def.value, value) {
+				continue
+			}
+			c := &MergeConflict{Section: section, Name: name, First: def.location, Second: loc}
+			if err := p.hooks.Conflict(c); err != nil {
+				return &ParseError{Location: loc, Path: path, Err: err}
+			}
+			p.types[path] = typeDefinition{location: loc, value: value}
+		}
+	}
+	return nil
+}
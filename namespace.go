@@ -0,0 +1,199 @@
+package toscalib
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// ErrNamespaceCollision is returned when a namespace prefix designates two different namespaces
+var ErrNamespaceCollision = errors.New("namespace collision")
+
+// typeReferences lists the keys whose value is the name of a type
+var typeReferences = map[string]bool{
+	"derived_from":       true,
+	"type":               true,
+	"entry_schema":       true,
+	"valid_source_types": true,
+	"valid_target_types": true,
+	"capability":         true,
+	"relationship":       true,
+	"node":               true,
+	"members":            true,
+	"targets":            true,
+}
+
+// templateReferences lists the keys of typeReferences naming templates within the topology
+// template: always when true, otherwise when the value is the name of a template of the document
+var templateReferences = map[string]bool{
+	"node":         false,
+	"relationship": false,
+	"members":      true,
+	"targets":      true,
+}
+
+// qualifier renames the types defined by an imported document, and the references to them, with
+// the namespace prefix of the import: Port becomes net:Port.
+type qualifier struct {
+	prefix    string
+	names     map[string]bool // the types defined by the document
+	templates map[string]bool // the templates, groups and policies defined by the document
+	topology  bool            // whether the value is within the topology template
+}
+
+// qualify prefixes the types of std, the types std refers to but does not define are unchanged,
+// as the references to its templates.
+func qualify(std *ServiceTemplateDefinition, prefix string) {
+	q := qualifier{prefix: prefix, names: make(map[string]bool), templates: make(map[string]bool)}
+
+	v := reflect.ValueOf(std).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		if isTypeSection(v.Type().Field(i)) {
+			for _, k := range v.Field(i).MapKeys() {
+				q.names[k.String()] = true
+			}
+		}
+	}
+
+	tt := std.TopologyTemplate
+	for _, names := range [][]string{sortedKeys(tt.NodeTemplates), sortedKeys(tt.RelationshipTemplates), sortedKeys(tt.Groups)} {
+		for _, name := range names {
+			q.templates[name] = true
+		}
+	}
+	for _, policies := range tt.Policies {
+		for name := range policies {
+			q.templates[name] = true
+		}
+	}
+
+	locations := std.Locations
+	std.Locations = nil
+	v.Set(q.value(v, ""))
+
+	if locations != nil {
+		std.Locations = make(map[string]SourceLocation, len(locations))
+		for path, loc := range locations {
+			std.Locations[q.path(path)] = loc
+		}
+	}
+}
+
+func isTypeSection(f reflect.StructField) bool {
+	return strings.HasSuffix(yamlName(f), "_types") && f.Type.Kind() == reflect.Map
+}
+
+func yamlName(f reflect.StructField) string {
+	return strings.Split(f.Tag.Get("yaml"), ",")[0]
+}
+
+func (q qualifier) name(s string) string {
+	if q.names[s] {
+		return q.prefix + ":" + s
+	}
+	return s
+}
+
+// path qualifies the type name within a dotted path such as node_types.Port.properties
+func (q qualifier) path(p string) string {
+	i := strings.Index(p, ".")
+	if i < 0 || !strings.HasSuffix(p[:i], "_types") {
+		return p
+	}
+	rest := p[i+1:]
+	// type names may contain dots, pick the longest defined name
+	for j := len(rest); j > 0; j = strings.LastIndex(rest[:j], ".") {
+		if q.names[rest[:j]] {
+			return p[:i+1] + q.name(rest[:j]) + rest[j:]
+		}
+	}
+	return p
+}
+
+// value returns a copy of v with the type references qualified, key is the name of the
+// element holding v.
+func (q qualifier) value(v reflect.Value, key string) reflect.Value {
+	switch v.Kind() {
+	case reflect.String:
+		if always, ok := templateReferences[key]; ok && q.topology && (always || q.templates[v.String()]) {
+			return v
+		}
+		if typeReferences[key] {
+			return reflect.ValueOf(q.name(v.String())).Convert(v.Type())
+		}
+
+	case reflect.Interface:
+		if v.IsNil() {
+			return v
+		}
+		nv := reflect.New(v.Type()).Elem()
+		nv.Set(q.value(v.Elem(), key))
+		return nv
+
+	case reflect.Struct:
+		if v.Type() == reflect.TypeOf(Assignment{}) {
+			// values are not type references
+			return v
+		}
+		nv := reflect.New(v.Type()).Elem()
+		nv.Set(v)
+		for i := 0; i < v.NumField(); i++ {
+			f := v.Type().Field(i)
+			name := yamlName(f)
+			if f.PkgPath != "" || name == "-" || name == "default" || name == "value" || name == "metadata" {
+				continue
+			}
+			if name == "" && f.Anonymous {
+				name = key
+			}
+			fq := q
+			if name == "topology_template" {
+				fq.topology = true
+			}
+			nv.Field(i).Set(fq.value(v.Field(i), name))
+		}
+		return nv
+
+	case reflect.Map:
+		if v.IsNil() {
+			return v
+		}
+		section := strings.HasSuffix(key, "_types")
+		generic := v.Type().Elem().Kind() == reflect.Interface
+		nv := reflect.MakeMapWithSize(v.Type(), v.Len())
+		for _, k := range v.MapKeys() {
+			nk, ctx := k, ""
+			if generic {
+				// a map decoded as is from YAML, the keys are the names of the elements
+				ctx = fmt.Sprint(k.Interface())
+			}
+			if section && k.Kind() == reflect.String {
+				nk = reflect.ValueOf(q.name(k.String())).Convert(k.Type())
+			}
+			nv.SetMapIndex(nk, q.value(v.MapIndex(k), ctx))
+		}
+		return nv
+
+	case reflect.Slice:
+		if v.IsNil() {
+			return v
+		}
+		nv := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		for i := 0; i < v.Len(); i++ {
+			nv.Index(i).Set(q.value(v.Index(i), key))
+		}
+		return nv
+	}
+	return v
+}
+
+// namespace checks a namespace prefix designates a single namespace, identified by its URI or
+// by the location of the document imported with the prefix.
+func (p *parser) namespace(scope, id string) error {
+	if prev, ok := p.spaces[scope]; ok && prev != id {
+		return fmt.Errorf("%w: prefix %s designates %s and %s", ErrNamespaceCollision, strings.TrimSuffix(scope, ":"), prev, id)
+	}
+	p.spaces[scope] = id
+	return nil
+}
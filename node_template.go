@@ -191,9 +191,15 @@ func (n *NodeTemplate) extendFrom(nt NodeType) {
 		}
 	}
 
-	abase := nt.Artifacts
-	_ = mergo.MergeWithOverwrite(&abase, n.Artifacts)
-	n.Artifacts = abase
+	if len(nt.Artifacts) != 0 {
+		// the artifacts of the type are copied, the type is shared by the templates
+		abase := make(map[string]ArtifactDefinition, len(nt.Artifacts)+len(n.Artifacts))
+		for k, v := range nt.Artifacts {
+			abase[k] = v
+		}
+		_ = mergo.MergeWithOverwrite(&abase, n.Artifacts)
+		n.Artifacts = abase
+	}
 
 	n._extendCaps(nt)
 	n._extendReqs(nt)
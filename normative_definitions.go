@@ -1,13 +1,37 @@
 // Code generated by go-bindata.
 // sources:
-// NormativeTypes/artifact_types
-// NormativeTypes/capability_types
-// NormativeTypes/data_types
-// NormativeTypes/group_types
-// NormativeTypes/interface_types
-// NormativeTypes/node_types
-// NormativeTypes/policy_types
-// NormativeTypes/relationship_types
+// NormativeTypes/tosca_simple_yaml_1_0/artifact_types
+// NormativeTypes/tosca_simple_yaml_1_0/capability_types
+// NormativeTypes/tosca_simple_yaml_1_0/data_types
+// NormativeTypes/tosca_simple_yaml_1_0/group_types
+// NormativeTypes/tosca_simple_yaml_1_0/interface_types
+// NormativeTypes/tosca_simple_yaml_1_0/node_types
+// NormativeTypes/tosca_simple_yaml_1_0/policy_types
+// NormativeTypes/tosca_simple_yaml_1_0/relationship_types
+// NormativeTypes/tosca_simple_yaml_1_1/artifact_types
+// NormativeTypes/tosca_simple_yaml_1_1/capability_types
+// NormativeTypes/tosca_simple_yaml_1_1/data_types
+// NormativeTypes/tosca_simple_yaml_1_1/group_types
+// NormativeTypes/tosca_simple_yaml_1_1/interface_types
+// NormativeTypes/tosca_simple_yaml_1_1/node_types
+// NormativeTypes/tosca_simple_yaml_1_1/policy_types
+// NormativeTypes/tosca_simple_yaml_1_1/relationship_types
+// NormativeTypes/tosca_simple_yaml_1_2/artifact_types
+// NormativeTypes/tosca_simple_yaml_1_2/capability_types
+// NormativeTypes/tosca_simple_yaml_1_2/data_types
+// NormativeTypes/tosca_simple_yaml_1_2/group_types
+// NormativeTypes/tosca_simple_yaml_1_2/interface_types
+// NormativeTypes/tosca_simple_yaml_1_2/node_types
+// NormativeTypes/tosca_simple_yaml_1_2/policy_types
+// NormativeTypes/tosca_simple_yaml_1_2/relationship_types
+// NormativeTypes/tosca_simple_yaml_1_3/artifact_types
+// NormativeTypes/tosca_simple_yaml_1_3/capability_types
+// NormativeTypes/tosca_simple_yaml_1_3/data_types
+// NormativeTypes/tosca_simple_yaml_1_3/group_types
+// NormativeTypes/tosca_simple_yaml_1_3/interface_types
+// NormativeTypes/tosca_simple_yaml_1_3/node_types
+// NormativeTypes/tosca_simple_yaml_1_3/policy_types
+// NormativeTypes/tosca_simple_yaml_1_3/relationship_types
 // DO NOT EDIT!
 
 package toscalib
@@ -75,162 +99,642 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _artifact_types = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xac\x93\x41\x6b\xe3\x30\x10\x85\xef\xfe\x15\xef\xb8\x7b\x58\x6f\xf6\xea\x5b\x76\x97\x42\x0e\xa1\xa5\x49\x73\x29\x41\xa8\xf6\x38\x1a\x90\x25\x21\x29\x21\xfe\xf7\xc5\x72\x9a\x90\xa2\x12\x07\x7a\x33\xcc\x7b\xdf\xbc\x37\xc8\xd1\x86\x5a\x8a\x86\x5a\x36\x1c\xd9\x9a\x20\x0e\xe4\x03\x5b\x53\x61\x1c\x05\xee\x9c\x26\xd1\xcb\x4e\x8b\x3f\x62\x26\x66\x45\x21\x7d\xe4\x56\xd6\x51\xc4\xde\x51\xa8\x0a\x00\xa3\xb8\xfc\x98\x84\xf2\xd9\xda\x38\x4e\x80\x86\x42\xed\xd9\xc5\x44\x5d\x2b\xc2\xfa\x71\xf5\x6f\x8e\xf9\x49\x8c\x75\xef\x08\x52\x6b\xd8\xa8\xc8\xe7\xa6\x01\x0d\x79\x3e\x10\x5a\x6f\xbb\x22\xbb\xf0\x81\x35\x5d\x16\x0e\xe2\x46\x0c\xea\x2a\x1b\x2d\xcf\xf8\x4f\x4e\xdb\xbe\x23\x13\xef\x20\xe5\x3a\xa6\x06\x6f\x32\x10\x86\x13\xa1\xb5\x1e\xcd\x99\x8d\x33\xe0\x56\x8a\x72\xd1\xc9\xdd\xc4\x56\x17\xd7\x34\x6a\xb9\x59\xde\x0b\x1e\x8d\xb9\xc2\x1b\xf6\x71\x2f\x35\x96\xb2\x56\x6c\x08\x3f\x36\xcb\x9f\x18\xd5\xd9\x30\x8b\xe1\x4d\x0d\x48\x99\xfc\xdf\x7e\x6c\xbe\xe2\xdf\x3a\xf8\x75\x9a\xf2\xaf\x0c\x6a\x5a\xa4\x6b\x63\x2e\xdc\x2a\x7d\x9e\x03\xa4\x70\x51\x11\x5e\x0c\x1f\x31\x2c\x42\x50\xa4\xf5\xc9\xda\x71\x47\xe9\xa7\xaa\x20\x9d\xd3\x5c\x27\xee\xef\xe3\xaf\xa0\x4e\x8a\x96\x35\x09\x3a\xc6\x0a\xaf\x08\x0a\xdb\x49\x85\x9e\xfa\xa8\xa6\x5e\xf9\x76\xa5\xf9\xe7\x2e\x6c\x22\x79\xe7\x29\x52\x83\x71\x15\xb4\x34\xbb\xfd\xe5\xad\x7c\xd9\xcb\x25\x79\xa6\x9b\xeb\xb1\x2d\xde\x03\x00\x00\xff\xff\x67\x2b\xbe\x8b\x9c\x04\x00\x00")
+var _tosca_simple_yaml_1_0Artifact_types = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xac\x93\x41\x6b\xe3\x30\x10\x85\xef\xfe\x15\xef\xb8\x7b\x58\x6f\xf6\xea\x5b\x76\x97\x42\x0e\xa1\xa5\x49\x73\x29\x41\xa8\xf6\x38\x1a\x90\x25\x21\x29\x21\xfe\xf7\xc5\x72\x9a\x90\xa2\x12\x07\x7a\x33\xcc\x7b\xdf\xbc\x37\xc8\xd1\x86\x5a\x8a\x86\x5a\x36\x1c\xd9\x9a\x20\x0e\xe4\x03\x5b\x53\x61\x1c\x05\xee\x9c\x26\xd1\xcb\x4e\x8b\x3f\x62\x26\x66\x45\x21\x7d\xe4\x56\xd6\x51\xc4\xde\x51\xa8\x0a\x00\xa3\xb8\xfc\x98\x84\xf2\xd9\xda\x38\x4e\x80\x86\x42\xed\xd9\xc5\x44\x5d\x2b\xc2\xfa\x71\xf5\x6f\x8e\xf9\x49\x8c\x75\xef\x08\x52\x6b\xd8\xa8\xc8\xe7\xa6\x01\x0d\x79\x3e\x10\x5a\x6f\xbb\x22\xbb\xf0\x81\x35\x5d\x16\x0e\xe2\x46\x0c\xea\x2a\x1b\x2d\xcf\xf8\x4f\x4e\xdb\xbe\x23\x13\xef\x20\xe5\x3a\xa6\x06\x6f\x32\x10\x86\x13\xa1\xb5\x1e\xcd\x99\x8d\x33\xe0\x56\x8a\x72\xd1\xc9\xdd\xc4\x56\x17\xd7\x34\x6a\xb9\x59\xde\x0b\x1e\x8d\xb9\xc2\x1b\xf6\x71\x2f\x35\x96\xb2\x56\x6c\x08\x3f\x36\xcb\x9f\x18\xd5\xd9\x30\x8b\xe1\x4d\x0d\x48\x99\xfc\xdf\x7e\x6c\xbe\xe2\xdf\x3a\xf8\x75\x9a\xf2\xaf\x0c\x6a\x5a\xa4\x6b\x63\x2e\xdc\x2a\x7d\x9e\x03\xa4\x70\x51\x11\x5e\x0c\x1f\x31\x2c\x42\x50\xa4\xf5\xc9\xda\x71\x47\xe9\xa7\xaa\x20\x9d\xd3\x5c\x27\xee\xef\xe3\xaf\xa0\x4e\x8a\x96\x35\x09\x3a\xc6\x0a\xaf\x08\x0a\xdb\x49\x85\x9e\xfa\xa8\xa6\x5e\xf9\x76\xa5\xf9\xe7\x2e\x6c\x22\x79\xe7\x29\x52\x83\x71\x15\xb4\x34\xbb\xfd\xe5\xad\x7c\xd9\xcb\x25\x79\xa6\x9b\xeb\xb1\x2d\xde\x03\x00\x00\xff\xff\x67\x2b\xbe\x8b\x9c\x04\x00\x00")
 
-func artifact_typesBytes() ([]byte, error) {
+func tosca_simple_yaml_1_0Artifact_typesBytes() ([]byte, error) {
 	return bindataRead(
-		_artifact_types,
-		"artifact_types",
+		_tosca_simple_yaml_1_0Artifact_types,
+		"tosca_simple_yaml_1_0/artifact_types",
 	)
 }
 
-func artifact_types() (*asset, error) {
-	bytes, err := artifact_typesBytes()
+func tosca_simple_yaml_1_0Artifact_types() (*asset, error) {
+	bytes, err := tosca_simple_yaml_1_0Artifact_typesBytes()
 	if err != nil {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "artifact_types", size: 1180, mode: os.FileMode(511), modTime: time.Unix(1476627076, 0)}
+	info := bindataFileInfo{name: "tosca_simple_yaml_1_0/artifact_types", size: 1180, mode: os.FileMode(436), modTime: time.Unix(1476627076, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _capability_types = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xc4\x57\xc1\x6e\xdb\x38\x10\xbd\xe7\x2b\x06\xd8\x6b\x6b\x24\x57\x1f\x16\x48\x9c\x62\x37\x40\x37\x0d\xea\xec\x5e\x16\x0b\x61\x4c\x8d\xac\x41\x28\x92\x1d\x0e\xd3\xba\x5f\xbf\x90\x4c\xd9\x8a\xed\xd4\x8a\x13\x20\x37\x81\x9c\x79\x1a\xbe\x79\x6f\x44\xa9\x8f\x06\x8b\x92\x2a\x76\xac\xec\x5d\x2c\x1e\x49\x22\x7b\x37\x85\xf5\x56\xe4\x26\x58\x2a\x56\xd8\xd8\xe2\xa2\x38\x2f\xce\xcf\xce\x0c\x06\x5c\xb0\x65\x5d\x15\xba\x0a\x14\xa7\x67\xb0\x0e\x9e\x6c\x76\x98\xe2\xe4\x52\x15\x4d\xdd\x90\xd3\x36\x00\xa0\x24\xe1\x47\x2a\x8b\x4a\x7c\x33\x3d\x94\xf0\xd5\x7b\x3d\x3b\x8c\x35\xf3\x4d\x48\x4a\x2f\x01\x6a\x23\x83\xf8\x40\xd2\xae\xad\x33\x01\x1c\x36\xd4\x3f\x03\xb4\xe5\x4f\x21\xaa\xb0\x5b\x6e\x16\x85\xbe\x25\x16\x2a\xa7\x50\xa1\x8d\xd4\x27\xa6\xa6\x30\x21\xc5\xdd\x64\x76\x4a\x4b\x92\x23\xd9\x00\xc6\xbb\xa8\x82\xec\x74\x00\x01\xf0\x11\x96\x42\xa8\x24\x85\x97\x82\xbe\x25\xb4\x53\xb8\xc8\xfb\x26\xa4\xa2\x6a\xf1\xc8\x99\xd5\x5e\xd1\x06\x2d\xca\xc7\xe4\x58\x27\x9b\xa0\xb7\xac\xe2\x7c\x72\x01\x7f\xfc\xf9\x33\x47\x95\x1c\x1f\x8a\xc8\x3f\xf7\xc9\x1b\xd4\xd1\xee\xbf\x69\x09\xf0\xd7\x55\x0e\x69\xa8\x79\x9f\xd7\x1f\xd6\xa3\x59\xeb\x71\x32\xf3\x4e\x91\x1d\xc9\x48\x65\x66\x1d\x3f\x83\xfa\xc9\x95\xc1\xf3\xcb\xfc\x72\x58\xe6\x41\xbc\x7a\xe3\xed\x68\xa9\xab\xa4\x2d\x47\x25\x55\x98\xac\x4e\x41\x4d\xe8\x01\xbd\xe8\x2e\xd8\x9d\x17\xbd\xa6\xea\x08\xe5\x91\x4c\x92\xbd\xbe\x2d\xbc\xb7\x84\xee\x68\xbb\x36\xa5\x0c\x97\x93\xd8\x22\xa0\xd6\x27\x19\xb9\x3d\x49\x71\xfa\x18\x20\xfd\xee\xe5\xe1\x74\x80\xc1\x91\xee\xbe\xde\xfc\x73\x79\xff\x29\x6f\x74\x03\x18\xd5\xcb\x6b\x61\xa3\x4f\x62\x46\x08\xfe\x11\x2d\x97\xc5\x23\xda\x44\x71\x0a\xff\xe6\xbc\x0f\xa0\x28\x4b\xd2\x0f\x10\x88\x04\xfe\x1b\xb0\xb6\x37\xfb\x1a\x0c\xa7\xfb\xad\x61\x57\x58\x72\x4b\xad\xb7\x13\x0f\x80\x9c\xca\xaa\x88\xa6\xa6\x06\x87\xf1\x5b\xc5\xcd\x03\x99\x6e\x03\x55\x85\x17\x49\xb7\xaa\xe7\x50\x60\x59\x0a\xc5\xbd\x4a\x33\x87\xbf\xb6\xdd\xe4\xb2\x6c\xd8\x8d\x34\x5f\x9f\xd4\x45\xff\x06\xb3\x1a\xdd\x92\xa0\x5f\xcd\xaa\x07\x76\x25\x9b\xb6\xa9\xa0\xbe\xf3\x18\xb4\x80\xc0\x1a\xfb\x7e\x81\xaf\x06\x8c\xed\x3b\x79\x9c\x7d\xb6\x8e\x1d\xfa\xf8\x59\xee\xf3\x80\xeb\x82\x8f\x70\x72\x8d\x8a\x0b\x8c\x63\x3f\xbd\x1b\x5a\x8e\xc0\xde\xa5\x85\x65\x73\x0a\xd7\xfb\x14\x6d\xd8\xd7\x9a\x36\xb4\x0e\x8d\xda\x92\x9f\xe2\x7a\xbf\x62\x89\x0a\xa1\x7b\x7d\x1f\x04\x95\x4f\xae\x7c\xb9\xc3\xb7\x4e\xfe\xfb\xea\xf3\xcd\x6c\x34\xef\x4f\xc2\x2b\xeb\x51\xd9\x2d\xa7\x03\xd8\x68\x84\x83\x76\xb7\xb0\xdf\x07\x00\x59\x4d\x14\x41\x6b\xd4\xee\x38\xf9\x20\x59\xf6\x10\x6b\x9f\x6c\x09\x0b\x02\xb4\xd6\xb7\xb1\xe5\x5a\x71\x08\xc1\x7b\xdb\x89\x2d\xbf\x0f\x6e\xee\x32\x0e\x0a\x01\xc6\xe8\x0d\x77\xf1\xdf\x59\xeb\x0e\x3b\x33\x31\x19\xab\xbd\xa7\xc6\x8f\x8a\x9a\xe2\x14\xe8\x47\x20\xe1\xf6\x1a\x88\xb6\xbf\x4c\xb8\xb8\x43\xef\x93\x23\xdf\xd7\x04\xbe\x7b\x46\x0b\x7d\xff\x84\x96\x1c\x95\x64\x5d\xde\xf5\xed\xfc\xb4\x29\x79\xb0\xaa\xc3\x5a\xbd\x5d\x1f\xff\x5d\xee\x9c\x87\x2b\xea\x1b\x72\xc5\xae\xc4\x85\x1d\xeb\xc9\x5b\x5f\x1e\x43\xfc\xcc\xee\xe1\x6d\x10\xdb\xad\xd7\xdf\xf7\xbf\x04\x92\x4e\xa5\xf3\x55\x54\x6a\x5e\xdf\x03\x14\x53\xb3\x92\xd1\x03\xc3\x74\x94\x74\xba\xd0\x53\x12\x4b\x8e\xeb\xef\x54\xab\xed\x53\x00\xfa\x1f\xb2\x9d\xdc\xbc\xfc\x42\x09\xb5\x14\xf5\x6c\xee\x58\xee\xfe\xcb\x7c\x76\x09\xe2\xbd\xc2\x6c\xf3\x87\x07\xf7\xab\xd0\x0d\x13\xf0\x5a\x93\xe4\xa0\xf6\x83\xb0\x1b\x14\x73\x7f\xba\x79\xf3\xcc\xdb\xe7\xed\x6d\x7d\xbc\xcc\x7e\xd1\xcf\xf6\xf6\xc0\x2e\x2a\x3a\x43\x47\xff\xc9\x36\x13\xaa\xbf\x67\x34\xf8\xe3\x15\xd9\x79\xe1\x38\xc2\x33\x24\xa8\x17\x5c\xbe\xcf\xbf\xec\xff\x01\x00\x00\xff\xff\xf7\x7a\xaf\xf5\xef\x0f\x00\x00")
+var _tosca_simple_yaml_1_0Capability_types = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xc5\x57\xcb\x6e\xdb\x3a\x10\xdd\xe7\x2b\x06\xe8\x36\x35\x92\xad\x17\x17\x48\x9c\xa2\x0d\xd0\xa6\x41\x9d\xdb\x4d\x51\x08\x63\x6a\x6c\x13\xa1\x48\x5d\x72\x94\xd6\xfd\xfa\x3b\xa2\x28\x45\xf1\x23\x96\x1d\x03\xdd\x08\x02\x39\x3c\x9c\xc7\x39\xa3\x11\xbb\xa0\x30\xcb\x69\xae\xad\x66\xed\x6c\xc8\x9e\xc8\x07\x79\x19\x03\xc7\xad\xa0\x8b\xd2\x50\xb6\xc2\xc2\x64\x97\xd9\x45\x76\x71\x76\xa6\xb0\xc4\x99\x36\x9a\x57\x19\xaf\x4a\x0a\xe3\x33\x68\x8c\x47\xdd\x8e\xa6\x30\xba\x62\x46\xb5\x2c\xc8\x72\x6d\x00\x90\x93\xd7\x4f\x94\x67\x73\xef\x8a\xf1\xb6\x03\xdf\x9c\xe3\xb3\xed\x58\x13\x57\x94\x15\xd3\x40\xa0\x89\xb3\x8c\xda\x92\x8f\xe6\xa5\x77\x25\xf9\x7a\xa3\x39\x0e\x60\xb1\xa0\xf6\x5d\xae\x93\x18\xc6\x10\xd8\x6b\xbb\xe8\x16\x3d\xfd\x57\x69\x4f\xf9\x18\xe6\x68\x02\xed\x74\x2b\x5d\x74\x48\x84\x3b\x7c\xaa\x8a\x4c\x95\x55\x58\xf7\x4b\x5b\xa6\x45\x8a\x64\x9b\x63\xed\xba\x92\xda\xb1\x17\x67\xb8\x07\x01\xf0\x1e\x16\x9e\x90\xc9\x67\xce\x67\x72\x14\xcd\x18\x2e\xd3\xbe\x5c\x27\xae\xca\x22\x59\xb5\xda\xc8\x87\x42\x83\xfe\x7d\x25\xb4\x18\x75\x46\xa7\xf4\xe2\x62\x74\x09\x1f\x3f\xfd\x49\x56\xb9\x0e\x8f\xc2\xb5\x3f\xf4\x9a\x1f\xf5\xfe\x49\x5d\x80\x2f\xd7\xc9\xa4\xa0\xe2\xef\x5c\xbf\x9d\x58\xaa\xe1\xfb\xc1\x04\x4b\x3a\xd9\x81\xfa\xc1\xe6\xa5\xd3\x87\xe9\x71\x3b\x5b\x65\x85\x9d\x72\x66\xb0\x8a\xd8\x57\xcf\x39\x92\x76\x83\x95\x61\x59\x55\x65\x0b\xe8\x3c\xaf\x83\x35\x2e\xe5\xc8\x18\xdb\xcc\xc8\x12\xff\x72\xfe\x71\x74\x2f\xb6\x37\x34\xdf\x53\x8a\x40\xaa\xf2\x1b\xf5\x9c\x39\x67\x08\xed\xde\x32\x76\x2e\xf6\x97\x2b\x6f\xb2\x12\x79\x79\x60\xef\x78\x8e\x30\x3b\xa2\xf3\xa4\xf6\xd0\xc4\x7e\x3c\x40\x2f\xa4\xfb\x6f\xb7\xdf\xaf\x1e\x3e\xa4\x8d\xd8\xf8\x91\x9d\x7f\x2b\x6c\x70\x95\x57\x03\x84\xf0\x84\x46\xe7\x99\x3c\x2b\xe1\x13\xfc\x48\xe7\xce\x81\xd1\x2f\x88\xcf\xa1\x24\xf2\xf0\xb3\x97\xb5\x8d\x9e\x58\x60\x79\xbc\x0e\x0b\x6d\x33\x43\x76\x21\x65\xec\x3a\x21\x80\x7c\xa5\xfc\x2a\x0b\x6a\x49\x05\xf6\xed\xf7\x33\x71\x5a\x92\x8a\x07\x90\x25\x61\x33\x51\x5f\x77\xa1\x2e\x33\xcc\x73\x4f\x21\xec\xc8\xed\xeb\x32\x1d\x5d\xe5\xe2\xec\x40\xb1\xb6\x87\xa2\xf5\x3b\x98\x2c\xd1\x2e\x08\xda\xd5\xa4\x06\x29\x76\xae\x55\x5d\x6c\x81\x88\x9a\x84\x1a\x10\x34\x87\xb6\x8e\xe0\xe6\xbd\x4c\x6e\x2a\x7f\x98\xac\x9e\x15\xde\xd7\xfd\xce\x9a\xa4\x86\x18\x8d\xf7\xe4\xe4\x46\x8a\x30\xc3\x40\x87\xa6\x65\x0f\xec\x7d\x35\x33\x5a\x1d\x93\xeb\xcd\x14\x75\xd9\xe7\x25\x75\x69\xed\x0b\xb8\x4e\x7e\x15\x9a\xfd\xb9\xf6\x81\xa1\x8c\xd7\xb7\x46\x30\x77\x95\xcd\x0f\x57\xfe\xb3\xc2\xff\xbd\xfe\x7c\x3b\x19\x9c\xf7\x17\xe6\x73\xe3\x90\x05\x75\xdc\x83\x0d\xca\xeb\x92\xe3\x54\xf8\x4f\x0f\x20\xb1\x89\x82\x44\x82\x1c\xc3\x49\x81\x24\xda\x43\x58\xba\xca\xe4\x30\x23\x40\x63\x5c\x6d\x9b\x37\x8c\x43\x51\xb6\x33\x91\x6c\xe9\x3e\xb8\xbd\x4f\x38\x28\x44\xc5\x10\x9c\xd2\xd1\xfe\x97\xe6\x65\xc4\x6e\x55\x37\x94\x7b\x2f\x1b\x42\x10\xf1\xca\x78\x05\xf4\x5b\xca\xa5\xeb\xb1\x14\x4d\x3b\x7c\xc8\xd8\xfb\x32\xbd\x2f\x42\x7e\x90\xbb\x5d\x7c\x47\x03\x6d\xfd\x3c\x2d\x74\x90\xef\x79\xe3\xde\xcd\xdd\xf4\xb8\xee\xb9\xd5\xab\xed\x5c\xbd\x6b\xc2\x3f\xc1\xa8\x79\xaa\xf1\xb7\x2d\xc8\xb5\x10\x01\x67\x66\xa8\x26\xef\x5c\xbe\x0f\xf1\xb3\xb6\x8f\xa7\x41\xac\xb7\xde\xfe\xff\xf1\x55\x52\x18\x59\x3a\x5d\x49\xd1\x8b\xb7\xd7\x00\xbd\x5a\x6a\x26\xc5\x5b\x9a\xe9\x20\xea\x44\xd3\x63\x0e\xca\xa4\xdd\x7c\xa7\x6a\x6e\x1f\x03\xd0\xfe\x20\xae\x9d\x4d\xcb\x07\x52\xa8\x4e\x51\x9b\xcd\x35\xc9\x3d\x7c\x9d\x4e\xae\xc0\x8b\x01\x4c\xba\x3f\x4e\x78\x90\xcb\xea\x66\x02\x4e\x5a\x82\x4f\x46\xf5\x07\x61\xdd\x28\xa4\xfa\xc4\x7e\xb3\xe3\xf6\x69\x3d\xdd\x0f\xa7\xd9\x2b\xf5\xac\xa7\x0a\x2d\x5d\x16\xad\xa2\xbd\xff\x70\x5d\x87\x6a\xe7\x8f\x02\x7f\xbf\xe1\x74\x5a\x18\x8e\x30\xac\x34\x53\x19\x13\x70\x41\x7f\xa5\xdf\xfc\x0f\xd0\x2f\x5c\x32\x97\x10\x00\x00")
 
-func capability_typesBytes() ([]byte, error) {
+func tosca_simple_yaml_1_0Capability_typesBytes() ([]byte, error) {
 	return bindataRead(
-		_capability_types,
-		"capability_types",
+		_tosca_simple_yaml_1_0Capability_types,
+		"tosca_simple_yaml_1_0/capability_types",
 	)
 }
 
-func capability_types() (*asset, error) {
-	bytes, err := capability_typesBytes()
+func tosca_simple_yaml_1_0Capability_types() (*asset, error) {
+	bytes, err := tosca_simple_yaml_1_0Capability_typesBytes()
 	if err != nil {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "capability_types", size: 4079, mode: os.FileMode(511), modTime: time.Unix(1480694932, 0)}
+	info := bindataFileInfo{name: "tosca_simple_yaml_1_0/capability_types", size: 4247, mode: os.FileMode(436), modTime: time.Unix(1792277475, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _data_types = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xcc\x95\xcf\x6e\xdb\x30\x0c\xc6\xef\x79\x0a\x3e\x40\x16\xb4\x18\xb2\x83\x6f\x43\x7b\xe9\x65\x1b\xd6\xdc\x86\x42\xe0\x2c\x3a\x11\x22\x4b\x1a\x49\xa7\xc8\xdb\x0f\xfe\x93\xc5\x5d\xd4\xc6\x2d\x02\xb4\xa7\x00\x24\xf5\x91\xdf\x4f\x16\xa3\x51\x4a\x34\x96\x2a\x17\x9c\xba\x18\xc4\xec\x88\xc5\xc5\x50\x40\x9f\x12\x57\x27\x4f\x66\x8f\xb5\x37\xd7\xe6\xca\x5c\xcd\x66\x16\x15\x8d\xee\x13\x49\x31\x03\x80\xbe\x70\xd1\x46\xbb\xe0\xe2\x67\x8c\xda\x67\x00\x2c\x49\xc9\x2e\x69\xa7\xb8\xda\x10\xac\xbe\xdf\xdf\x7c\x05\x8e\x51\xe1\x16\x15\x61\xb5\x4f\x04\xe8\x3d\x44\xdd\x10\x0f\xe9\xdf\x28\x74\x4c\x0b\x58\x62\xb7\x23\xa8\x38\xd6\xb3\x6c\xcb\x1b\x26\x4b\x41\x1d\xfa\x63\xe3\xf6\x88\x35\xed\x99\x22\x3b\xe2\x50\x98\x38\x26\x62\x75\x07\x37\x43\x4c\x63\x19\xfd\x31\x02\xd0\x1e\x2c\x40\x94\x5d\x58\x8f\xc2\x4c\x7f\x1a\xc7\x64\x0b\xa8\xd0\x0b\xfd\xcb\x68\xdc\x52\xe8\x28\x4d\x10\xb1\x54\x61\xe3\xb5\x80\x84\x22\x8f\x91\xed\x53\x99\xb3\x0a\x5b\xda\xcb\x69\x51\x8d\x69\xc2\xa0\x00\x14\x94\xf7\x46\xca\x0d\xd5\x38\x56\x79\xa6\x59\x23\xc4\x6f\x00\x93\xbd\xb7\x95\xab\xe9\x2e\x28\xf1\xee\x42\x37\x27\x8a\xac\x46\x5d\x9d\xc1\xde\x46\x45\xb1\xce\x53\x51\x6e\x8e\x50\x28\xd8\xb7\x8b\x64\x9d\x06\xd2\xc7\xc8\xdb\xc5\xb7\xfe\xf7\x2e\x54\xf1\x22\x86\x07\x5d\x13\x30\x37\xed\x7f\xb7\x72\x28\x76\xf6\x6c\x29\x5a\xcb\x24\x42\x99\xef\xca\x3b\xd1\xd7\x7f\x3e\x2f\x52\xf9\x11\x59\x2f\x86\x24\x45\xd6\x69\x3c\xba\xca\x09\x30\x5e\xc1\xad\xc6\xd2\x0c\xec\x3e\x20\xe3\x5b\xaa\xf2\x88\x5d\x50\x5a\x13\x0f\xb9\x32\x06\x51\x46\x17\x74\x34\xda\x27\x70\xc1\x30\x86\x35\x15\xf0\x0b\xae\xe7\xf0\x65\xb9\xfc\xbc\x84\x87\xf3\x5d\xef\x13\x95\xef\xb7\x97\x9f\x3c\xec\xd1\xae\xd5\x72\xfc\x86\xb3\x96\x7b\xdb\x3b\xf4\xce\x9a\x1d\xfa\x86\xa4\xb5\xde\xd8\x34\x6f\x4f\xcf\xc1\xad\xeb\x04\x0f\xc7\x65\x8d\xbc\x26\x3d\x1d\x6e\x20\x3f\xe5\x5f\xa3\x53\x18\x28\x9f\xe8\x74\xe1\x49\x2b\xfd\x05\x37\xcf\x5c\xe2\xa1\x40\x62\xc3\x65\xa6\xf7\x74\x0f\xbd\xc2\x7b\x78\xf8\x1b\x00\x00\xff\xff\x2f\x6c\xda\xd7\xcd\x08\x00\x00")
+var _tosca_simple_yaml_1_0Data_types = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xcd\x55\xcb\x6e\xdb\x40\x0c\xbc\xfb\x2b\xf8\x01\xae\x91\xa0\x70\x0f\xba\x15\xc9\x25\x97\xb6\x68\x7c\x2b\x8a\xc5\x56\x4b\xc9\x0b\xef\xab\x5c\xca\x85\xff\xbe\x94\xac\xd8\x0a\xac\xd8\x4a\xe0\x22\xd5\xc1\x32\xf8\x18\x72\x86\xbb\x14\xc7\x5c\x6a\x65\xb0\xb2\xc1\xb2\x8d\x21\xab\x2d\x52\x96\x3f\x05\x70\xe7\xca\xd6\x27\x87\x6a\xa7\xbd\x53\xb7\xea\x46\xdd\xcc\x66\x46\xb3\x56\xbc\x4b\x98\x8b\x19\xc8\xd3\x05\x2e\x5a\x6b\x67\x5c\x7c\x8f\x91\xf7\x1e\x00\x83\xb9\x24\x9b\xb8\x43\x5c\xad\x11\x56\x5f\x1f\xef\x3e\x03\x49\x08\xdc\x4b\x06\xac\x24\x05\xb4\x73\x10\x79\x8d\xd4\xbb\x7f\xe9\x8c\x47\x77\x16\x14\xb2\x5b\x84\x8a\xa2\x9f\x8d\x96\xbc\x23\x34\x18\xd8\x6a\x77\x2c\xdc\xa6\x18\xd5\xe6\x14\xa3\x2d\xf6\x81\x89\x62\x42\x62\xfb\xc4\xa6\xb7\x71\x2c\xa3\x3b\x5a\xa4\xa4\x24\x16\x90\x99\x6c\xa8\x07\x66\xc2\xdf\x8d\x95\xea\x05\x54\xda\x65\x3c\x78\x38\x6e\x30\x74\x2a\x4d\x00\x11\xfd\x75\xe3\xb8\x80\xa4\x73\xfe\x13\xc9\x3c\x87\xb9\x88\xb0\xc1\x5d\x3e\x0d\xf2\x3a\x4d\x68\x14\x40\x84\xa3\x9d\xca\xe5\x1a\xbd\x1e\xa2\xbc\x50\xac\xc9\x48\x6f\x10\x66\x74\x6e\x2b\xeb\xf1\x21\x30\xd2\xf6\x4a\x93\xcb\xac\x89\x15\x0b\xec\x69\x8b\xad\x55\xfc\x7e\x5c\x15\xa6\xe6\x28\x0a\x06\xf3\x76\x90\x51\xa6\x01\x59\x06\xbb\x59\x7c\xd9\xbf\x1f\x42\x15\xaf\x42\xb8\xc7\x55\x41\xfb\xcb\x27\xed\x29\xd8\x9a\x8b\xa1\xda\x18\xc2\x9c\x71\xe4\x5c\x39\x9b\xf9\xf5\xc7\xe7\xac\x2a\xdf\x22\xf1\xd5\x24\x49\x02\x36\x4d\x8f\x2e\x72\x82\x18\xaf\xd0\xcd\xeb\x52\xf5\xda\xfd\x87\x1a\xdf\x63\x35\x2e\xb1\x95\x4b\x58\x23\xf5\xbe\x52\x3e\x04\x4c\x5a\x8c\x83\xd6\x3e\x48\x90\x22\x1d\x6a\xa9\xf7\x03\x6e\xe7\xf0\x69\xb9\xfc\xb8\x84\x9f\x97\xab\x3e\x26\x2c\xdf\x6f\x2f\x3f\xbb\xd8\x83\x5d\xcb\xe5\xf0\x0e\x8f\x52\xde\xd3\x96\xdd\x64\x8d\x92\xdf\x46\x7a\x11\xea\x8d\x49\xf3\x36\x7b\x0e\xb6\xf6\x49\x04\x38\xb4\xa2\xa9\x46\x1e\xd9\x19\xe7\x27\x32\xe5\x6b\xd2\x21\xf7\xea\x9f\xe0\x77\xe6\x49\xab\xfe\x0c\xcb\x17\x86\x7b\x58\xac\xb1\xa1\x12\xff\x05\xb7\x3d\xf2\x7b\x70\xfb\x0b\x6c\xa3\x66\xce\xfd\x08\x00\x00")
 
-func data_typesBytes() ([]byte, error) {
+func tosca_simple_yaml_1_0Data_typesBytes() ([]byte, error) {
 	return bindataRead(
-		_data_types,
-		"data_types",
+		_tosca_simple_yaml_1_0Data_types,
+		"tosca_simple_yaml_1_0/data_types",
 	)
 }
 
-func data_types() (*asset, error) {
-	bytes, err := data_typesBytes()
+func tosca_simple_yaml_1_0Data_types() (*asset, error) {
+	bytes, err := tosca_simple_yaml_1_0Data_typesBytes()
 	if err != nil {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "data_types", size: 2253, mode: os.FileMode(511), modTime: time.Unix(1476627095, 0)}
+	info := bindataFileInfo{name: "tosca_simple_yaml_1_0/data_types", size: 2301, mode: os.FileMode(436), modTime: time.Unix(1792277475, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _group_types = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x64\x8f\xbb\x6a\xc5\x30\x0c\x86\x77\x3f\x85\x9e\xc0\x9c\xae\x67\x2b\x1d\x3a\x16\x7a\xb2\x1b\x63\xcb\x8d\xc0\xb1\x8c\xa4\x06\xfc\xf6\x25\x37\x32\x54\xe3\xf7\x5f\x24\x19\x6b\x8a\x21\x63\xa1\x46\x46\xdc\x34\xac\x28\x4a\xdc\x9e\x70\x48\x4a\x4b\xaf\x18\x46\x5c\x6a\x78\x0b\x8f\xf0\x70\xee\x47\xf8\xb7\x07\x1b\x1d\xf5\xe9\x00\xe0\x70\xfa\x1d\xab\xff\x66\xb6\x03\x03\x64\xd4\x24\xd4\x6d\xef\x9b\x66\x84\xe9\xeb\xf5\xf1\x0e\x9f\x9b\x13\xa6\xd1\x11\x62\xad\xc0\x36\xa3\xfc\x93\x14\x32\x0a\xad\x08\x45\x78\x39\xfb\xa8\x19\x4a\x89\xe9\x5a\xbc\xcd\xcb\x62\xcb\x51\xf2\x4d\x00\xb6\xdb\xce\x07\xfc\x9d\xf1\x8d\x33\xfa\x4a\x05\xd3\x48\x15\xfd\x95\x74\x7f\x01\x00\x00\xff\xff\xed\x2a\x99\xb7\x05\x01\x00\x00")
+var _tosca_simple_yaml_1_0Group_types = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x64\x8f\xbb\x6a\xc5\x30\x0c\x86\x77\x3f\x85\x9e\xc0\x9c\xae\x67\x2b\x1d\x3a\x16\x7a\xb2\x1b\x63\xcb\x8d\xc0\xb1\x8c\xa4\x06\xfc\xf6\x25\x37\x32\x54\xe3\xf7\x5f\x24\x19\x6b\x8a\x21\x63\xa1\x46\x46\xdc\x34\xac\x28\x4a\xdc\x9e\x70\x48\x4a\x4b\xaf\x18\x46\x5c\x6a\x78\x0b\x8f\xf0\x70\xee\x47\xf8\xb7\x07\x1b\x1d\xf5\xe9\x00\xe0\x70\xfa\x1d\xab\xff\x66\xb6\x03\x03\x64\xd4\x24\xd4\x6d\xef\x9b\x66\x84\xe9\xeb\xf5\xf1\x0e\x9f\x9b\x13\xa6\xd1\x11\x62\xad\xc0\x36\xa3\xfc\x93\x14\x32\x0a\xad\x08\x45\x78\x39\xfb\xa8\x19\x4a\x89\xe9\x5a\xbc\xcd\xcb\x62\xcb\x51\xf2\x4d\x00\xb6\xdb\xce\x07\xfc\x9d\xf1\x8d\x33\xfa\x4a\x05\xd3\x48\x15\xfd\x95\x74\x7f\x01\x00\x00\xff\xff\xed\x2a\x99\xb7\x05\x01\x00\x00")
 
-func group_typesBytes() ([]byte, error) {
+func tosca_simple_yaml_1_0Group_typesBytes() ([]byte, error) {
 	return bindataRead(
-		_group_types,
-		"group_types",
+		_tosca_simple_yaml_1_0Group_types,
+		"tosca_simple_yaml_1_0/group_types",
 	)
 }
 
-func group_types() (*asset, error) {
-	bytes, err := group_typesBytes()
+func tosca_simple_yaml_1_0Group_types() (*asset, error) {
+	bytes, err := tosca_simple_yaml_1_0Group_typesBytes()
 	if err != nil {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "group_types", size: 261, mode: os.FileMode(511), modTime: time.Unix(1476627106, 0)}
+	info := bindataFileInfo{name: "tosca_simple_yaml_1_0/group_types", size: 261, mode: os.FileMode(436), modTime: time.Unix(1476627106, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _interface_types = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x9c\x93\xcd\x6e\xe3\x3a\x0c\x85\xf7\x7e\x0a\xbe\xc0\x35\x7a\xb7\xdd\x0d\xba\x9a\x55\x81\x69\xf6\x02\x63\xd1\x31\x01\x59\x14\x28\xc6\x85\xdf\x7e\xe0\xbf\x8c\xe3\xba\x18\x61\xb6\xd1\xe1\x77\x3e\x53\x91\x49\x6e\xd0\x79\x6a\x39\xb2\xb1\xc4\xec\x06\xd2\xcc\x12\x5f\x61\x39\xca\xdc\xa7\x40\x6e\xc4\x3e\xb8\xff\xdd\x8b\x7b\xa9\x2a\x8e\x46\xda\x62\x43\xce\xc6\x44\xf9\xb5\x82\x25\x5b\x3f\x0e\x72\xfd\x4b\xc4\xa6\x03\x00\x4f\xca\x03\x79\xd7\xaa\xf4\x2b\xb4\xa6\x68\x6c\xe3\x1c\x5a\x33\xb9\x51\x4e\x36\xf7\x5e\x3a\x82\xcb\xfb\xc7\xdb\x0f\x50\x11\x83\x9f\x1b\x14\x2e\x63\x22\xc0\x10\x40\xac\x23\x5d\x33\x57\xcc\x74\xc8\xe4\xb5\x13\xa6\xca\xea\xcc\x2e\x8a\xa7\x3a\x70\x4b\xcd\xd8\x04\xaa\x3f\x0c\xa3\x47\xf5\xdf\x0b\x1f\xbe\x6c\xce\x35\x4a\x68\xb4\xcc\x1c\x3e\x61\x03\xc2\xa3\x63\x4d\x83\x24\x52\x9c\x42\xf5\xc2\x90\xd8\xf2\xed\xae\xe5\x98\x6d\xe0\x48\xca\x86\x6a\xa5\x94\x39\xfc\x95\x20\xa9\x1c\x20\xe9\x38\xef\x29\x50\xf9\x3e\x96\xf4\x9e\x71\x76\x51\x4a\x61\x3e\xcd\x1d\xa7\xfa\xed\x79\x59\xa5\xf7\x94\x94\xdc\x63\x6d\x2e\xcb\x5d\x9b\x73\xcb\xf7\xcd\x05\x4c\xa6\xa9\xff\xfe\x2c\xdb\x3a\x82\x65\x12\x28\xfa\x24\x1c\xad\x3e\x81\x1b\xea\x8d\xce\x6f\xe1\x2f\xf0\x65\xf2\x08\x97\x6c\xff\xa2\x2e\xd9\x8a\xdc\x9f\xf1\xc5\xf2\x5f\xf1\xa7\xf6\xe8\x7d\x31\x33\x8a\x71\x3b\xee\x55\xa7\x27\x0a\xd2\x02\x6e\xf0\xf9\x87\x2b\x71\xbc\x4d\x64\xf2\x30\x30\x02\xc2\xd3\x3f\xe4\xd1\x5b\xba\xaa\x5d\xef\xbe\x66\xee\xdd\x8b\x7c\x76\xdc\x74\xc0\x19\xa2\x7c\x02\x0e\xc8\x01\xaf\x81\xbe\x55\x58\x58\xae\xe9\x30\xde\xc8\x17\x6b\xac\x8d\x59\x7a\x82\xa4\xd3\xd3\xb0\x11\x44\x01\xcd\x94\xaf\x77\x9b\xc5\x76\xae\x2b\x7f\xc6\x2b\xf5\x32\x94\x5f\xe2\x12\x7f\xde\x6e\x5d\xfd\x0e\x00\x00\xff\xff\xca\x8d\x54\x44\x0e\x06\x00\x00")
+var _tosca_simple_yaml_1_0Interface_types = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x9c\x93\xcd\x6e\xe3\x3a\x0c\x85\xf7\x7e\x0a\xbe\xc0\x35\x7a\xb7\xdd\x0d\xba\x9a\x55\x81\x69\xf6\x02\x63\xd1\x31\x01\x59\x14\x28\xc6\x85\xdf\x7e\xe0\xbf\x8c\xe3\xba\x18\x61\xb6\xd1\xe1\x77\x3e\x53\x91\x49\x6e\xd0\x79\x6a\x39\xb2\xb1\xc4\xec\x06\xd2\xcc\x12\x5f\x61\x39\xca\xdc\xa7\x40\x6e\xc4\x3e\xb8\xff\xdd\x8b\x7b\xa9\x2a\x8e\x46\xda\x62\x43\xce\xc6\x44\xf9\xb5\x82\x25\x5b\x3f\x0e\x72\xfd\x4b\xc4\xa6\x03\x00\x4f\xca\x03\x79\xd7\xaa\xf4\x2b\xb4\xa6\x68\x6c\xe3\x1c\x5a\x33\xb9\x51\x4e\x36\xf7\x5e\x3a\x82\xcb\xfb\xc7\xdb\x0f\x50\x11\x83\x9f\x1b\x14\x2e\x63\x22\xc0\x10\x40\xac\x23\x5d\x33\x57\xcc\x74\xc8\xe4\xb5\x13\xa6\xca\xea\xcc\x2e\x8a\xa7\x3a\x70\x4b\xcd\xd8\x04\xaa\x3f\x0c\xa3\x47\xf5\xdf\x0b\x1f\xbe\x6c\xce\x35\x4a\x68\xb4\xcc\x1c\x3e\x61\x03\xc2\xa3\x63\x4d\x83\x24\x52\x9c\x42\xf5\xc2\x90\xd8\xf2\xed\xae\xe5\x98\x6d\xe0\x48\xca\x86\x6a\xa5\x94\x39\xfc\x95\x20\xa9\x1c\x20\xe9\x38\xef\x29\x50\xf9\x3e\x96\xf4\x9e\x71\x76\x51\x4a\x61\x3e\xcd\x1d\xa7\xfa\xed\x79\x59\xa5\xf7\x94\x94\xdc\x63\x6d\x2e\xcb\x5d\x9b\x73\xcb\xf7\xcd\x05\x4c\xa6\xa9\xff\xfe\x2c\xdb\x3a\x82\x65\x12\x28\xfa\x24\x1c\xad\x3e\x81\x1b\xea\x8d\xce\x6f\xe1\x2f\xf0\x65\xf2\x08\x97\x6c\xff\xa2\x2e\xd9\x8a\xdc\x9f\xf1\xc5\xf2\x5f\xf1\xa7\xf6\xe8\x7d\x31\x33\x8a\x71\x3b\xee\x55\xa7\x27\x0a\xd2\x02\x6e\xf0\xf9\x87\x2b\x71\xbc\x4d\x64\xf2\x30\x30\x02\xc2\xd3\x3f\xe4\xd1\x5b\xba\xaa\x5d\xef\xbe\x66\xee\xdd\x8b\x7c\x76\xdc\x74\xc0\x19\xa2\x7c\x02\x0e\xc8\x01\xaf\x81\xbe\x55\x58\x58\xae\xe9\x30\xde\xc8\x17\x6b\xac\x8d\x59\x7a\x82\xa4\xd3\xd3\xb0\x11\x44\x01\xcd\x94\xaf\x77\x9b\xc5\x76\xae\x2b\x7f\xc6\x2b\xf5\x32\x94\x5f\xe2\x12\x7f\xde\x6e\x5d\xfd\x0e\x00\x00\xff\xff\xca\x8d\x54\x44\x0e\x06\x00\x00")
 
-func interface_typesBytes() ([]byte, error) {
+func tosca_simple_yaml_1_0Interface_typesBytes() ([]byte, error) {
 	return bindataRead(
-		_interface_types,
-		"interface_types",
+		_tosca_simple_yaml_1_0Interface_types,
+		"tosca_simple_yaml_1_0/interface_types",
 	)
 }
 
-func interface_types() (*asset, error) {
-	bytes, err := interface_typesBytes()
+func tosca_simple_yaml_1_0Interface_types() (*asset, error) {
+	bytes, err := tosca_simple_yaml_1_0Interface_typesBytes()
 	if err != nil {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "interface_types", size: 1550, mode: os.FileMode(511), modTime: time.Unix(1476627117, 0)}
+	info := bindataFileInfo{name: "tosca_simple_yaml_1_0/interface_types", size: 1550, mode: os.FileMode(436), modTime: time.Unix(1476627117, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _node_types = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xbc\x59\xcd\x8e\xdb\x38\x0c\xbe\xcf\x53\x08\xe8\x65\x17\xe8\x04\x53\xa0\xd8\x43\x6e\x93\x49\x77\xb7\x40\xe7\x07\xcd\x14\x3d\x14\x03\x83\x91\x98\x44\x5b\x59\x74\x25\x3a\xd3\xec\x69\x5f\x63\x5f\x6f\x9f\x64\x21\xff\xc5\x89\x9d\xd8\x71\x3a\xed\xa5\x19\x9b\xa4\x28\xf2\xfb\x28\x8a\x66\xf2\x12\x22\x85\x0b\x6d\x35\x6b\xb2\x3e\x5a\xa3\xf3\x9a\xec\x58\xe4\xaf\xbc\x8e\x13\x83\xd1\x06\x62\x13\xbd\x89\xae\xa2\xab\x8b\x0b\x4b\x0a\x23\xde\x24\xe8\xc7\x17\x22\x17\x1b\x85\x67\x7e\x34\x63\x72\xb0\xc4\xd1\xc4\x90\xfc\x5a\xfc\x11\x64\x84\x50\xe8\xf4\x1a\x55\xb4\x70\x14\x8f\x77\x74\x3e\x12\x71\x26\x92\x38\x4a\xd0\xb1\xce\xcd\x86\x7f\x5e\xff\x8d\xe5\x6f\x21\xc2\x8a\x63\xe1\x25\x18\x70\x97\xa9\xd5\x3c\x0a\xef\xab\xd7\x92\xac\x67\x07\xda\xb2\xdf\xea\x08\x71\x29\x96\x0e\x81\xd1\x45\xe4\x22\xfc\x96\x82\x19\x8b\x37\xe2\x76\x52\x88\xac\xc9\xa4\x31\x46\x5a\x35\xd6\x61\xa7\xed\xb2\x7a\xe8\xf0\x5b\xaa\x1d\xaa\xb1\x58\x80\xf1\xe5\xaa\xde\x42\xe2\x57\xc4\xc3\xf4\x25\x24\x30\xd7\x46\xd7\xb7\x0c\xcc\x20\x57\x31\x5a\xde\x37\x98\xc7\xac\xae\x33\xba\xae\x84\x2f\xf6\x12\x71\x43\x71\x92\x72\xff\xd8\x03\xb3\xd3\xf3\x94\xb7\x8e\x24\x4e\xaf\x81\x31\x02\xa5\x1c\x7a\x7f\x74\x7b\x49\x3a\x37\x5a\xf6\x12\xb5\xc8\xcf\xe4\xbe\x36\x84\x62\x48\xaa\x27\x68\xd9\x6d\x22\x2f\x57\x18\x43\x3d\x95\xf5\x38\x28\x60\xc8\x20\x38\x2a\x2c\x8e\xee\xf2\xff\xdf\xdb\x05\x95\x6e\x91\xe3\x17\x58\xe8\x81\x1c\x57\xab\x14\x79\x0d\x39\xa8\x96\xba\x14\x86\x24\x98\xc8\xd7\x09\x50\x80\xb4\x4c\xdf\xa6\x2b\xa1\x5b\x9d\x90\xaa\xdd\xb4\xd5\xe9\x55\x13\x74\x68\x20\xe3\xf0\x4a\x27\xa5\x42\xfd\x59\x69\x1f\xfd\x23\xd5\xd4\x48\xca\xd4\x39\xb4\x12\xfd\x58\x7c\xb9\x7a\x2d\x3e\xdd\x4d\xee\x3f\xdd\x4d\xdf\x4d\x9f\x0e\xc2\x74\x45\xbe\x0f\x40\x6f\xc8\x32\x68\x8b\xae\x12\x5d\x83\xd1\x2a\xf2\x94\x3a\x59\xd6\x10\xf1\x65\xa7\x84\xd0\x82\x9f\xc1\x61\x40\x30\x59\xb4\xfc\x74\x51\x26\x4b\x25\xa4\x7b\xf1\xe2\x5d\x21\x3a\xba\x56\xb1\xb6\x85\x3c\x35\xa0\xd0\xa2\x79\x9f\xa0\x03\xd6\x76\x39\xdb\x78\xc6\xb8\xa4\x79\xa8\x39\x73\xd3\xa8\x45\x2d\x06\x66\x85\x68\x21\x39\xd7\x56\x69\xbb\xec\xa1\x58\xa2\x6b\xa2\xad\xca\x0c\x34\x18\x5d\x84\x72\x74\x9d\x24\x46\xcb\x2c\xab\xbd\xf9\xdd\x0e\xd3\xdd\x2c\x76\xa2\xb3\x99\xcd\x36\x70\x6e\x1d\xfd\x98\x5a\xd6\xf1\x69\x08\xfd\x93\x3c\xa3\xba\xb7\x95\x8f\xa7\x93\x68\x97\x18\x97\x65\xc9\x39\xc1\xc2\x3b\xab\x1e\x02\x7e\x0e\xe7\xa0\xd8\x5a\x57\xfc\x1b\x60\xfe\xd1\x84\x1a\x82\xcc\xbd\x4d\x4d\x81\x61\x0e\xfe\x9c\x73\xda\x42\xdc\x3c\xa7\x77\xcf\x3f\x85\x5e\x3a\x9d\x70\xde\x59\xac\x50\x18\x5a\x6a\x09\x26\xd3\x15\xb4\xc8\x9e\xa9\xc2\x95\x5a\xfd\xde\x37\xab\x2d\xe3\xb2\x86\xc0\x86\xdd\xa0\x94\xfd\x48\xad\x42\x67\x36\xda\x2e\x2b\xbb\xc2\xa3\x5b\x6b\x89\xe2\x59\x1b\x23\x8c\xf6\x8c\x56\x30\x89\x05\xb9\x4c\xa6\xb0\x9a\x7a\x74\xa7\x6e\x87\xb2\xdf\x60\x32\x65\x01\x52\x52\x6a\x39\xdf\x5c\xb0\x3e\x9d\x08\x08\xb5\x48\x87\x16\x25\x48\x76\x34\x16\x09\x78\xff\x4c\xae\xab\xab\x38\xec\x46\x69\x20\x5b\x3d\xbc\x99\x4e\x76\x5c\x3b\xba\xfe\x4f\xac\x16\xd3\xc9\xed\x6c\x78\x81\x68\x63\x52\x99\xec\x68\xc8\x81\x51\x92\xa1\x41\x92\xc9\xed\x6c\x18\xd9\x9b\x6c\x71\x44\x1c\xf5\x4c\x70\x3b\x3a\x8e\x25\x3e\x58\x6f\xcb\xfe\xed\xac\x44\xff\x09\xec\xea\xbd\x7c\xc5\xba\xfa\x42\x87\x68\x26\xc0\xaa\xcc\x34\x7a\xf6\x3f\xa9\xc5\x68\x2d\x79\xe2\x69\x3f\xcf\x1f\x08\xd4\x04\x0c\x58\x59\x96\x80\x41\x05\x11\xcc\x92\x9c\xe6\x55\x3c\x30\xbd\x9e\x81\x53\x3f\x16\xf8\x3d\x41\xa7\x03\x0f\xc1\x1c\x0c\x94\x34\xba\xdf\x75\xa1\x42\xf9\x43\xd6\xaf\x57\x0a\x5d\x0d\x60\x6b\xc6\x7f\x37\x94\x35\x4a\xe2\x97\xf7\x0f\xbf\x16\x3e\xfc\xf7\xcf\xbf\x5e\x90\xcd\x11\x91\xad\x51\x1e\xbe\x42\x82\x0d\x37\x34\x8b\x92\x05\x1f\x6b\x9d\x61\xbf\xbb\xe9\x55\x6c\xca\xad\x9d\x54\x4a\x3e\x52\xb8\xf0\x9c\xd4\x0b\xb7\x04\xe3\x26\xdf\x95\xa6\x0c\xe3\x64\x51\x90\x13\x31\xb9\x70\xc4\x81\x12\xf3\x1c\x4d\xaa\xbe\x31\xbf\x0f\xbb\xbd\x3b\xcc\x19\xc8\xd3\x49\x75\x81\x1f\xcc\xed\x05\xa4\x86\xc7\xe2\x6d\xf7\xd5\x3a\xa7\xda\x1a\x4c\x9a\x93\xec\xed\x6b\xf1\x9b\x28\x23\x25\xb5\xea\x3a\x46\x0f\xdc\xaa\x19\x1c\x47\x3a\x19\xa4\x8c\x56\x0d\x55\x5d\x02\xe3\x33\x6c\x86\xaa\x17\x49\x8c\x7a\x74\x43\xc7\x0d\x0c\x1d\x46\xe0\x32\xab\x14\x01\x61\x43\x6d\x94\x2e\x64\x2a\x43\x0c\x24\xab\x8d\x0f\x7d\x5d\xd4\xe8\xba\xcf\x1a\x8b\x18\x6d\x1b\xa6\x8e\x5c\xa2\x3e\x68\xfb\xb5\xad\xd7\xad\x5f\xe1\xcf\x23\x59\x9f\x59\xc7\xa1\x18\x91\x53\xcd\x06\xf3\x30\x3d\xd9\xa5\x2d\xec\xbc\xea\x66\x67\x73\xf0\x55\x2a\x69\x1f\x95\x86\xf6\xdc\x98\x13\x19\x84\xae\xf6\xb4\xe6\x47\xfd\xb1\x4e\x22\x07\x76\x89\x51\xc6\xe0\x41\xb1\xa9\x4c\xa0\x1d\x82\xe0\x96\x33\xe5\x72\x0f\x3c\x1d\x27\x49\x03\x42\xdb\xe5\xba\xce\x93\xba\x6a\x75\xac\x5c\x36\x67\x00\x3d\x3d\xa8\x26\x01\xa7\x7b\x10\x54\x83\x07\x07\xc6\xb3\xf7\xf3\xbf\x50\xf2\xf9\xf3\xd9\xce\x4a\xf7\x52\x03\xdc\x2b\xf1\x47\x39\xc0\x8d\xe1\xfb\x8b\xaf\xd2\x56\x91\x8a\xb9\xc4\x90\x5b\xc6\x7e\x5a\x42\x84\x0f\x67\x01\x2d\x6b\xde\x6c\xd3\xb0\xd3\x7c\x3c\xae\x50\x3c\xde\xcf\x6e\xae\xc5\x1d\x29\x14\x8f\x9b\x04\x05\x18\x23\x88\x57\xe8\x8a\x37\x59\xa7\x5b\xbd\xf6\xc5\x22\x22\xac\x91\x19\x6c\xce\x7e\xf3\xc9\x7f\xc7\x11\x92\x0b\x75\x43\x80\x81\x8f\x49\xb4\xc5\x76\x81\xc0\xa9\xeb\x33\xd4\x08\xdb\x3a\xd2\x4c\x2a\x4c\xd0\x2a\xb4\x72\x73\x42\x2f\x59\xd9\x2c\x40\xde\xb8\xb3\x56\xa9\xe8\x4b\xcb\x69\xe6\x86\xbf\xb7\x07\x3b\x4d\x51\x6f\x35\x8b\x0e\x2a\x9c\x08\x6e\x01\x72\x1b\x97\x19\x83\x55\xd0\xbc\x34\xe6\x6b\x6e\xe5\x33\x3f\x47\x46\x2f\x50\x6e\xa4\xc1\x51\xa9\xd7\xa8\x07\xfb\x37\xd6\x33\x6a\xc1\x2b\xa1\x28\x06\x6d\x2f\x7d\x82\x52\x2f\xb4\x14\xbe\xb0\x2e\x64\x69\x5e\x14\xad\x69\xd9\x20\x96\xcf\x0f\xb5\xac\xbb\xe2\x87\x8e\x8c\x6c\xb0\x12\x49\x87\x2a\x50\x05\x4c\x7b\x74\xb6\x73\xfc\x9b\x4a\xf2\xd4\xa3\xe4\xe5\x46\xa6\xd9\xd7\x9a\x93\x30\x55\xcd\x41\xf6\x72\xfa\x19\xe7\x43\x06\xc4\xcd\x84\x4a\xb2\x8c\xdf\x39\x72\x55\x75\xea\xcd\x5f\x48\x92\x41\x75\xf1\x27\x87\xfd\x33\xce\x67\xe8\xd6\x3b\x52\x67\x05\x3e\xb7\xf6\xe3\x86\xc2\xaf\xc4\x43\xfe\x15\xee\xb5\x30\xb0\x41\x27\xde\x56\x5f\x41\x7c\x21\x12\x60\xbd\x8d\x75\x8f\xab\x71\xce\x96\x5e\x1a\x3b\x9f\x4f\x5e\x70\x1c\xb3\x0b\x59\xf1\x74\xf1\x7f\x00\x00\x00\xff\xff\x68\xd7\xc5\x36\x95\x1e\x00\x00")
+var _tosca_simple_yaml_1_0Node_types = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xcd\x59\xcd\x6e\xe3\x36\x10\xbe\xfb\x29\x08\xec\xa5\x05\x62\x21\x0b\x04\x3d\xf8\x16\xc7\xdb\x76\x81\x4d\x1c\xc4\x59\xf4\xb0\x08\x04\x5a\xa2\x6c\x36\x14\xa9\x92\x94\x13\xf7\xd4\xd7\xe8\xeb\xed\x93\x74\x48\x89\xb2\xac\x1f\xeb\xc7\x9b\xa0\x7b\xd8\xf5\x4a\xf3\x47\xce\xf7\x0d\x87\x23\x2d\x54\x80\xfd\x90\x44\x94\x53\x4d\x05\x57\xfe\x8e\x48\x05\x3f\x66\x48\xdb\x57\x8a\xc6\x09\x23\xfe\x1e\xc7\xcc\xff\xe8\x5f\xfa\x97\x93\x09\x17\x21\xf1\xf5\x3e\x21\x6a\x36\x41\x99\x98\x67\x9e\x29\x6f\xa5\x85\xc4\x1b\xe2\xcd\x99\x08\x9e\xf3\xff\x18\x19\x84\x42\x22\xe9\x8e\x84\x7e\x24\x45\x3c\x3b\xd2\x79\x10\x42\x5b\x91\x44\x8a\x84\x48\x4d\x33\xb3\xe6\x8f\xa2\x7f\x13\xf7\x1b\x1c\x81\xc7\x19\x02\x45\x86\xe5\x34\x85\x70\x3d\xf3\xbe\x78\x1d\x40\xf0\x5a\x62\xca\xb5\x3a\xe8\x20\x34\x45\x1b\x49\xb0\x26\xd2\x17\xd2\x27\x7f\xa5\x98\xcd\xd0\x47\x74\x3b\xcf\x45\x76\x82\xa5\x31\xf1\x69\x58\xf3\xa3\x25\xe5\x9b\xe2\xa1\x04\x55\x2a\x49\x38\x43\x11\x66\xca\x79\x55\x1c\x27\x6a\x2b\xf4\x38\xfd\x00\x27\x78\x4d\x19\x2d\x2f\x19\x6b\x8d\x83\x6d\x4c\xb8\xae\x1a\xcc\xf6\xac\xac\xe3\x5d\x17\xc2\x93\x4a\x22\x6e\x44\x9c\xa4\xba\xff\xde\x83\x5b\x49\xd7\xa0\x51\x04\x92\x80\x0e\x6c\x9b\x8f\xc3\x50\x12\xa5\x4e\x2e\x2f\x49\xd7\x8c\x06\xbd\x44\x39\xd1\x2f\x42\x3e\xd7\x84\x62\x9c\x14\x4f\x60\x3d\x72\xef\xab\x60\x4b\x62\x5c\x4e\x65\x79\x1f\x42\xac\xb1\x85\xa0\x97\x5b\xf4\xee\xb2\x7f\x3f\xf3\x48\xb8\xb0\x84\xd4\x6f\xe0\xe8\x1e\xcc\x16\x5e\xf2\xbc\x9a\x1c\x14\xae\xa6\x08\xe0\x8f\x99\xaf\xca\x04\xc8\x41\xea\xd2\xb7\xef\x4a\xe8\x41\xc7\xa4\x6a\xd6\x49\xb3\x92\x82\x24\x0c\x5b\x2e\x6f\x69\xe2\x14\xcb\xcf\x9c\x1f\xa2\x1e\x45\x49\x4d\x04\x41\x2a\x25\xe1\x01\x80\x00\x7d\xbb\xbc\x40\x5f\xef\xe6\xcb\xaf\x77\x8b\x4f\x8b\xa7\x56\xb8\x6e\x85\xea\x03\xd4\x1b\xc1\x35\x30\x93\xc8\x42\x74\x87\x19\x0d\x7d\x25\x52\x19\xb8\x5a\x82\xbe\x1d\xad\x51\x44\xfa\x05\x4b\x62\x90\x2c\x38\xec\xc8\xd3\xc4\x25\x2d\x4c\x04\xed\xc5\x8f\x4f\xb9\xa8\x77\x1d\xc6\x94\xe7\xf2\x42\xf5\xd0\x5c\x42\x25\x82\xfd\xe2\x9b\xd5\x5e\x69\x12\x3b\xba\x9b\xda\xb3\x66\xa4\x87\x81\x55\x2e\x9a\x4b\xae\x29\x0f\xc1\x5a\x0f\x45\x87\xb2\x39\x68\x58\x03\x35\x66\xe7\x5b\xe9\x5d\x27\x09\xb0\xce\x66\xb5\x37\xcf\x9b\xe1\x7a\x9c\xc5\x4e\x94\xd6\xb3\xd9\x04\xd2\x43\xa0\x0f\x29\xd7\x34\x1e\x86\xd0\xdf\x21\x24\x12\x2e\x79\x11\xe3\x70\x32\x1d\x13\x63\xea\x4a\xcf\x00\x0b\x0e\x3f\xed\x39\xc8\x97\xd6\xb5\xff\x35\x30\xff\x68\x42\x8d\x41\x66\x65\x51\x0b\x28\x74\x6b\xac\xce\x39\xaf\x39\x8e\x49\xc7\x39\x08\x16\x02\x49\x13\x9d\x75\x18\x5b\x02\xa5\x72\x03\x20\x66\x56\x17\x89\xc8\x3e\x0b\xf3\x50\x4a\x75\xbc\x6a\x16\xb2\x42\x36\x25\x04\xd6\xec\x1a\x25\xfb\x23\xe5\xb0\x16\xb6\x87\x20\x0a\xbb\x48\x11\xb9\xa3\x01\x41\x2f\x94\x31\xc4\x28\x40\x8d\xc3\x1a\x51\x24\xa4\x95\xe9\x38\xf5\x53\x50\x1f\xba\x4c\x61\x7f\xc3\x3a\x8d\x32\xc2\x41\x20\x00\x39\xd9\xa2\x8d\xd7\xc5\x1c\x61\x53\xa3\xa8\x69\x61\x8c\x64\x47\x08\x09\x56\x0a\xc0\x1c\x8e\x0e\xc3\x19\xb0\xde\xcd\x1b\x88\xa0\x1c\xda\x49\xff\xef\x58\x45\x16\xf3\xdb\xd5\xf8\xc2\xd1\xc4\x30\x07\x02\x7f\xcc\x41\xe2\x48\x52\x23\x0f\x84\x39\xae\x08\xd4\x59\x24\x81\x60\x7e\xcf\x04\x37\xa3\xe3\x54\xe2\x8d\xf5\xa6\xec\xdf\xae\x1c\x2b\x06\xb0\xae\xb7\xfb\x82\x8d\x65\x47\x6d\xf4\x43\x98\x87\xd6\x34\x51\x5a\xbd\x53\xeb\xd1\x58\x0a\xd1\x53\x35\xcf\x5f\x04\x0e\xe7\x50\x3f\xa1\x49\x92\x67\x14\x4a\xcc\x36\x42\x52\xbd\x8d\x47\xa6\x57\x41\x4f\x9a\x42\xd8\xe4\x15\x0c\x53\xc3\x43\xcc\x5a\x37\x2a\x60\x94\x0c\x43\xf9\xbd\xed\xe7\x27\x7d\x1b\xc3\xc6\x8c\xff\xca\x84\x6d\xa0\xd0\x4f\x9f\xef\x7f\xce\x63\xf8\xfe\xcf\xbf\x0a\x09\x9e\x21\xc2\xfa\x70\x87\x32\x04\xce\xcd\x0d\x8e\x93\x00\x70\x72\xaa\xb5\xc6\xd5\xae\x67\xd8\x49\x3e\xa4\x94\x3c\x08\x73\x21\x1a\xd4\x23\x37\x6c\xc6\x4d\xb6\x2a\x2a\x2c\xc6\x81\xf8\x08\x60\x1e\x0b\x69\x8e\x3e\x1c\xa2\x75\x86\xa6\xb0\xbc\x30\x55\x85\x5d\xe5\x8e\x73\x06\xf2\x68\x52\x5c\xf0\x47\x73\x3b\xc2\x29\xd3\x33\x74\xd5\x7d\xf5\xce\xa8\x06\x7f\xa7\x19\xc9\xae\x2e\xd0\x2f\xc8\xed\x54\x40\x43\x39\xee\xd6\xad\xb1\x84\x2b\x77\x32\x4a\x19\xaa\xfe\x58\xd5\x0d\xdc\x87\x5f\xf0\x7e\xac\x7a\x9e\x44\xbf\x47\x97\x74\xda\xc0\xd8\x61\x05\xd9\xd8\x4a\x61\x10\x36\xd6\x86\x0b\xc1\xaa\x8c\x31\x90\x6c\xf7\xca\xf4\x7b\x7e\xad\x1b\x3f\x6b\x6c\xc2\x28\x7f\x1e\x70\xb9\xfa\x02\xe2\x4d\x3d\x70\xf9\x8a\x7f\x1e\xc9\xfa\xcc\x42\xda\xf6\x08\x0e\xe6\x7a\x83\xd9\x4e\x4f\x2d\xd3\x06\x76\x5e\x8e\x19\x8c\x39\x25\xaa\x7c\x67\xa8\x12\xc6\x5a\x08\x46\x30\xef\x5f\x25\xca\x8f\x61\x5f\x24\xe6\x1b\xe2\x5b\x06\x8f\xda\x9b\xc2\x04\xf0\x78\x84\x81\x86\x33\x65\x5a\x01\x4f\xc7\x49\x52\x83\x50\xff\xf3\xa4\xac\x5a\x1c\x2b\xd3\xfa\x6c\xa0\x67\x04\xc5\x84\x60\x78\x04\x46\xd5\x44\xd0\x32\xbe\x5d\xae\xff\x84\x13\xeb\xfc\xf9\x6d\x67\xa5\x7b\xab\x01\xef\x25\xfa\xcd\x0d\x78\x63\xfc\xfa\xe6\x5e\x9a\x2a\x52\x3e\xaf\x18\x73\xcb\xa8\xa6\xc5\xec\x70\x7b\x16\x00\xca\x80\x94\x43\x1a\x8e\x9a\x8f\x47\xe8\xb4\x1e\x97\xab\x9b\x6b\x74\x07\xa6\xd0\x23\xb8\x86\xe6\x93\x21\x01\x2d\x98\xcc\xdf\xd8\x4e\xb7\x78\xad\x72\x27\xc8\xf8\x68\x99\x0d\x67\x5f\x06\x3a\x8e\x90\x4c\xa8\x1b\x02\x70\x20\x9d\x92\x68\xda\xdb\x08\x32\x91\xca\x3e\xc3\x0e\xb3\xac\x13\xcd\x64\x48\x12\xc8\x0f\xb4\x73\xfb\x01\xbd\x64\x61\xb3\xed\xce\x5a\xa4\xa2\x2f\x2d\x17\x36\x0c\xb5\xe4\xad\x9d\x26\x2a\xb7\x9a\x79\x07\x65\x4e\x04\x19\xe1\xe0\xb0\x2f\x2b\x0d\x37\x27\x5c\xbf\x34\x66\x3e\x0f\xf2\x36\x4e\x8f\xd1\x88\x04\xfb\x80\x11\xcf\xe9\xd5\xea\x41\xf5\xc6\x7a\x46\x2d\xf8\x80\x42\x11\x03\xad\xa6\x2a\x21\x01\x8d\xa0\xf5\x57\xb9\x75\xa0\x5c\x6e\x1e\xe5\xad\xa9\x6b\x10\xdd\xf3\xb6\x96\xf5\x58\xbc\xed\xc8\xb0\x83\x15\x3f\x80\xe7\x86\x2a\x40\xdc\xc9\xe9\x39\xff\x4d\x21\xf9\x7f\x19\x82\xe4\x5f\x73\xc6\xcd\x41\x2a\x39\xfd\x83\xac\xc7\x0c\x8e\xeb\x09\x85\x42\xa9\xc9\xab\xf6\x65\x51\x9d\xce\xff\xf8\x95\x24\xa3\xea\xe5\x3b\xa7\x03\xb6\x70\x45\xe4\xee\x48\xea\xac\x84\x64\xd6\x7e\xdc\x10\xf9\x03\xba\xcf\xbe\xde\x5d\x20\x86\xf7\x50\xe8\xaf\x8a\xaf\x26\xaa\x34\x05\x3b\xec\x75\x8f\x2b\x73\xc6\xa2\x5e\x1a\x47\x9f\x5b\xde\x70\x4c\x73\x0c\x65\xa8\x8a\xff\x01\xda\xea\x71\xda\xcd\x1e\x00\x00")
 
-func node_typesBytes() ([]byte, error) {
+func tosca_simple_yaml_1_0Node_typesBytes() ([]byte, error) {
 	return bindataRead(
-		_node_types,
-		"node_types",
+		_tosca_simple_yaml_1_0Node_types,
+		"tosca_simple_yaml_1_0/node_types",
 	)
 }
 
-func node_types() (*asset, error) {
-	bytes, err := node_typesBytes()
+func tosca_simple_yaml_1_0Node_types() (*asset, error) {
+	bytes, err := tosca_simple_yaml_1_0Node_typesBytes()
 	if err != nil {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "node_types", size: 7829, mode: os.FileMode(511), modTime: time.Unix(1480697558, 0)}
+	info := bindataFileInfo{name: "tosca_simple_yaml_1_0/node_types", size: 7885, mode: os.FileMode(436), modTime: time.Unix(1792275752, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _policy_types = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xc4\x92\x41\x6a\xf3\x30\x10\x85\xf7\x3e\xc5\x3b\x41\xc8\xbf\xcd\xee\xa7\x07\x68\x68\xd2\xb5\x10\xd2\x38\x19\x90\x35\xea\x8c\x6c\xf0\xed\x8b\xe5\x96\x14\x6a\x68\xc9\xa2\xd9\xce\xd3\xbc\xef\x93\x50\x15\x0b\xde\x45\xea\x39\x73\x65\xc9\xe6\x26\x52\x63\xc9\x07\xac\x91\xf1\x50\x12\xb9\xd9\x0f\xc9\xfd\x73\x7b\xb7\xef\xba\x22\x89\xc3\xec\xea\x5c\xc8\x0e\x1d\x80\xf5\xe8\xae\xcd\x99\x6c\xf7\x22\x52\xd7\x00\x88\x64\x41\xb9\xd4\x56\x79\xbe\x12\xce\xcf\xa7\xa7\xff\x38\xb6\x0e\x9c\xe7\x42\xf0\x29\x41\xea\x95\xf4\x7b\x66\x88\xa4\x3c\x11\x7a\x95\xa1\xdb\x62\x1d\x93\x0f\x34\x50\xfe\x02\x5c\x16\xa2\x5b\x36\x0e\x5b\x66\xbf\x17\xbb\x3d\x0b\xea\xd5\x57\xb0\x61\x34\x8a\xa8\x82\x8b\x4c\xa4\x19\xe5\x93\x0e\xe9\x3f\x0a\xb2\x44\x32\x88\xe2\xa2\x32\x16\x5b\x82\x36\xda\x6d\xea\x9f\x82\x4f\x9c\x2f\x0f\x91\xb7\x95\x7d\xaf\xfa\x6b\x89\xbe\xd2\x43\xcc\xc7\x86\xbe\x57\xfc\x48\xda\x8b\x0e\x3e\x87\x3f\xb6\x8f\x14\x92\x57\x42\xb9\x09\x40\xe9\x6d\x64\x6d\x7f\xc8\xd0\x8b\xfe\x78\xa3\xf7\x00\x00\x00\xff\xff\x8b\xf6\x26\x41\xb2\x03\x00\x00")
+var _tosca_simple_yaml_1_0Policy_types = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xc4\x92\x41\x6a\xf3\x30\x10\x85\xf7\x3e\xc5\x3b\x41\xc8\xbf\xcd\xee\xa7\x07\x68\x68\xd2\xb5\x10\xd2\x38\x19\x90\x35\xea\x8c\x6c\xf0\xed\x8b\xe5\x96\x14\x6a\x68\xc9\xa2\xd9\xce\xd3\xbc\xef\x93\x50\x15\x0b\xde\x45\xea\x39\x73\x65\xc9\xe6\x26\x52\x63\xc9\x07\xac\x91\xf1\x50\x12\xb9\xd9\x0f\xc9\xfd\x73\x7b\xb7\xef\xba\x22\x89\xc3\xec\xea\x5c\xc8\x0e\x1d\x80\xf5\xe8\xae\xcd\x99\x6c\xf7\x22\x52\xd7\x00\x88\x64\x41\xb9\xd4\x56\x79\xbe\x12\xce\xcf\xa7\xa7\xff\x38\xb6\x0e\x9c\xe7\x42\xf0\x29\x41\xea\x95\xf4\x7b\x66\x88\xa4\x3c\x11\x7a\x95\xa1\xdb\x62\x1d\x93\x0f\x34\x50\xfe\x02\x5c\x16\xa2\x5b\x36\x0e\x5b\x66\xbf\x17\xbb\x3d\x0b\xea\xd5\x57\xb0\x61\x34\x8a\xa8\x82\x8b\x4c\xa4\x19\xe5\x93\x0e\xe9\x3f\x0a\xb2\x44\x32\x88\xe2\xa2\x32\x16\x5b\x82\x36\xda\x6d\xea\x9f\x82\x4f\x9c\x2f\x0f\x91\xb7\x95\x7d\xaf\xfa\x6b\x89\xbe\xd2\x43\xcc\xc7\x86\xbe\x57\xfc\x48\xda\x8b\x0e\x3e\x87\x3f\xb6\x8f\x14\x92\x57\x42\xb9\x09\x40\xe9\x6d\x64\x6d\x7f\xc8\xd0\x8b\xfe\x78\xa3\xf7\x00\x00\x00\xff\xff\x8b\xf6\x26\x41\xb2\x03\x00\x00")
 
-func policy_typesBytes() ([]byte, error) {
+func tosca_simple_yaml_1_0Policy_typesBytes() ([]byte, error) {
 	return bindataRead(
-		_policy_types,
-		"policy_types",
+		_tosca_simple_yaml_1_0Policy_types,
+		"tosca_simple_yaml_1_0/policy_types",
 	)
 }
 
-func policy_types() (*asset, error) {
-	bytes, err := policy_typesBytes()
+func tosca_simple_yaml_1_0Policy_types() (*asset, error) {
+	bytes, err := tosca_simple_yaml_1_0Policy_typesBytes()
 	if err != nil {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "policy_types", size: 946, mode: os.FileMode(511), modTime: time.Unix(1476627148, 0)}
+	info := bindataFileInfo{name: "tosca_simple_yaml_1_0/policy_types", size: 946, mode: os.FileMode(436), modTime: time.Unix(1476627148, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _relationship_types = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xbc\x54\x4d\x6f\x13\x41\x0c\xbd\xe7\x57\xf8\x0f\x10\xb5\xd7\xbd\x95\x80\xc4\x01\x51\xa9\xe4\x86\xaa\x91\x33\xe3\x24\x56\x67\xed\xc1\xe3\x04\xe5\xdf\xa3\xdd\xcd\x17\x94\x2c\x04\x29\x3d\xee\xfa\x3d\x3f\xbf\x37\xb2\x5d\x6b\xc4\x90\x68\xc9\xc2\xce\x2a\x35\x6c\xc9\x2a\xab\x34\x30\x94\x2a\xb7\x25\x53\xd8\x61\x9b\xc3\x7d\xb8\x0b\x77\x93\x89\x51\xc6\x1e\xbb\xe6\x12\x7c\x57\xa8\x36\x13\x18\xe0\xd3\xf3\x5a\x9d\x3e\xb8\x63\x5c\x53\x9d\x6b\x87\x00\x48\x64\xbc\xa5\x14\x96\xa6\x6d\xf3\x47\xc6\x93\xaa\xf7\xd0\x2d\x66\x4e\xc1\xd1\x56\xe4\x7b\x11\xf8\xb6\xa7\x44\x2c\xb8\xe0\xcc\xce\x74\xd0\x68\x49\x1c\x9e\x7b\x66\x31\x2d\x64\x5d\x6d\x10\x05\xc8\x1a\x7b\x89\xc3\x37\x40\xd7\xb0\x81\xea\xc6\xb2\x3a\xfe\x8c\x2a\xd5\x0d\x59\xbc\x9e\x90\x00\xef\xa0\x65\x09\x99\x64\xe5\xeb\x06\xee\xf7\x95\x44\x5b\x8e\xf4\x97\x8e\x46\xdf\x37\x6c\x94\x1a\x58\x62\xae\x34\xb9\x10\xd3\x4c\x45\x28\xfa\x4d\x63\xfa\x28\xa9\x28\x8f\x84\x14\x8d\x12\x89\x33\xe6\xdf\x4d\x0d\xdd\x12\x3a\xf6\x02\xd3\xd9\x11\x79\xad\xd1\x0f\x54\x48\x52\x7d\x94\xdb\xf9\xfc\xa2\x89\xe0\xf9\xd2\x00\x9f\xb4\x3a\xa5\x5b\xea\xcf\x54\x1c\x59\xc8\x2e\x0f\x21\xe4\x3f\xd4\x5e\xa6\xef\x59\xd2\x15\x6f\x7e\x0c\xef\xba\x81\xce\xd5\x70\x91\x47\xc2\x39\x20\x3f\xb3\xbc\xbc\xdd\x5c\x9d\xda\xf8\x5c\xdd\x23\x1c\x86\xa9\xd1\xb8\xf4\xbb\x0c\xf3\x35\xc1\xfc\xf1\xeb\xec\x01\x4c\xd5\xe1\xe9\x8c\x03\xf3\x5d\x21\xc0\x9c\x41\x7d\x4d\xb6\x87\x2d\xb0\xd2\x6b\x58\xdd\x5b\x84\xce\x61\xaf\x82\xee\xc6\x8b\x8d\x9f\x76\x63\x38\x85\x9c\x46\xd7\x7d\x00\x09\xb6\x63\x57\x81\xc5\xc9\x96\x18\x4f\xbd\x67\x2a\x4b\x5e\x6d\xec\x15\x6b\x88\xe2\x44\xf8\x25\x95\xe9\x91\x76\x39\xb5\xce\xc1\xbf\x3f\xe3\xe9\x08\xfd\xf7\x61\xf9\x19\x00\x00\xff\xff\xf9\x61\xcf\xc6\x4d\x06\x00\x00")
+var _tosca_simple_yaml_1_0Relationship_types = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xbc\x54\x4d\x6f\x13\x41\x0c\xbd\xe7\x57\xf8\x0f\x10\xb5\xd7\xbd\x95\x80\xc4\x01\x51\xa9\xe4\x86\xaa\x91\x33\xe3\x24\x56\x67\xed\xc1\xe3\x04\xe5\xdf\xa3\xdd\xcd\x17\x94\x2c\x04\x29\x3d\xee\xfa\x3d\x3f\xbf\x37\xb2\x5d\x6b\xc4\x90\x68\xc9\xc2\xce\x2a\x35\x6c\xc9\x2a\xab\x34\x30\x94\x2a\xb7\x25\x53\xd8\x61\x9b\xc3\x7d\xb8\x0b\x77\x93\x89\x51\xc6\x1e\xbb\xe6\x12\x7c\x57\xa8\x36\x13\x18\xe0\xd3\xf3\x5a\x9d\x3e\xb8\x63\x5c\x53\x9d\x6b\x87\x00\x48\x64\xbc\xa5\x14\x96\xa6\x6d\xf3\x47\xc6\x93\xaa\xf7\xd0\x2d\x66\x4e\xc1\xd1\x56\xe4\x7b\x11\xf8\xb6\xa7\x44\x2c\xb8\xe0\xcc\xce\x74\xd0\x68\x49\x1c\x9e\x7b\x66\x31\x2d\x64\x5d\x6d\x10\x05\xc8\x1a\x7b\x89\xc3\x37\x40\xd7\xb0\x81\xea\xc6\xb2\x3a\xfe\x8c\x2a\xd5\x0d\x59\xbc\x9e\x90\x00\xef\xa0\x65\x09\x99\x64\xe5\xeb\x06\xee\xf7\x95\x44\x5b\x8e\xf4\x97\x8e\x46\xdf\x37\x6c\x94\x1a\x58\x62\xae\x34\xb9\x10\xd3\x4c\x45\x28\xfa\x4d\x63\xfa\x28\xa9\x28\x8f\x84\x14\x8d\x12\x89\x33\xe6\xdf\x4d\x0d\xdd\x12\x3a\xf6\x02\xd3\xd9\x11\x79\xad\xd1\x0f\x54\x48\x52\x7d\x94\xdb\xf9\xfc\xa2\x89\xe0\xf9\xd2\x00\x9f\xb4\x3a\xa5\x5b\xea\xcf\x54\x1c\x59\xc8\x2e\x0f\x21\xe4\x3f\xd4\x5e\xa6\xef\x59\xd2\x15\x6f\x7e\x0c\xef\xba\x81\xce\xd5\x70\x91\x47\xc2\x39\x20\x3f\xb3\xbc\xbc\xdd\x5c\x9d\xda\xf8\x5c\xdd\x23\x1c\x86\xa9\xd1\xb8\xf4\xbb\x0c\xf3\x35\xc1\xfc\xf1\xeb\xec\x01\x4c\xd5\xe1\xe9\x8c\x03\xf3\x5d\x21\xc0\x9c\x41\x7d\x4d\xb6\x87\x2d\xb0\xd2\x6b\x58\xdd\x5b\x84\xce\x61\xaf\x82\xee\xc6\x8b\x8d\x9f\x76\x63\x38\x85\x9c\x46\xd7\x7d\x00\x09\xb6\x63\x57\x81\xc5\xc9\x96\x18\x4f\xbd\x67\x2a\x4b\x5e\x6d\xec\x15\x6b\x88\xe2\x44\xf8\x25\x95\xe9\x91\x76\x39\xb5\xce\xc1\xbf\x3f\xe3\xe9\x08\xfd\xf7\x61\xf9\x19\x00\x00\xff\xff\xf9\x61\xcf\xc6\x4d\x06\x00\x00")
 
-func relationship_typesBytes() ([]byte, error) {
+func tosca_simple_yaml_1_0Relationship_typesBytes() ([]byte, error) {
 	return bindataRead(
-		_relationship_types,
-		"relationship_types",
+		_tosca_simple_yaml_1_0Relationship_types,
+		"tosca_simple_yaml_1_0/relationship_types",
 	)
 }
 
-func relationship_types() (*asset, error) {
-	bytes, err := relationship_typesBytes()
+func tosca_simple_yaml_1_0Relationship_types() (*asset, error) {
+	bytes, err := tosca_simple_yaml_1_0Relationship_typesBytes()
 	if err != nil {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "relationship_types", size: 1613, mode: os.FileMode(511), modTime: time.Unix(1480697573, 0)}
+	info := bindataFileInfo{name: "tosca_simple_yaml_1_0/relationship_types", size: 1613, mode: os.FileMode(436), modTime: time.Unix(1480697573, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _tosca_simple_yaml_1_1Artifact_types = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xad\x93\x4f\x6b\xc3\x30\x0c\xc5\xef\xf9\x14\x3a\x6e\x87\x65\xf4\x9a\x5b\xb7\x31\xe8\xa1\x6c\xac\x5d\x2f\x63\x18\x2f\x51\x6a\x81\x13\x1b\x5b\x2d\xcd\xb7\x9f\xe3\xf4\x0f\x1d\x1e\x4d\xa1\x39\x19\xf4\xf4\xd3\x7b\x42\x61\xe3\x4b\x29\x2a\xac\xa9\x25\x26\xd3\x7a\xb1\x45\xe7\xc3\xa3\x00\x8e\x25\x4f\x8d\xd5\x28\x3a\xd9\x68\x31\x11\x93\x2c\x93\x8e\xa9\x96\x25\x0b\xee\x2c\xfa\x22\x83\xf0\x45\x69\x7e\xa8\xf8\xfc\xc3\x18\x1e\x2a\x00\x15\xfa\xd2\x91\xe5\xc8\x5c\x2a\x84\xe5\xdb\xe2\x79\x0a\xd3\xbd\x18\x96\x01\x03\x52\x6b\x30\xac\xd0\xa5\xaa\x3e\x30\x1c\x6d\x11\x6a\x67\x9a\x2c\x39\xf0\x95\x34\x9e\x06\xf6\xe2\x4a\xf4\xea\x22\x69\x2d\xcd\x78\x41\xab\x4d\xd7\x60\xcb\x57\x90\x52\x19\x63\x82\x1f\xe9\x11\xfa\x15\x41\x6d\x5c\x10\x1c\xd8\x70\x04\x5c\x72\x91\xcf\x1a\xb9\x1e\x99\xea\xd4\x35\x8e\x9a\xaf\xe6\xd7\x82\x87\xc6\x54\xe0\x15\x39\xde\x48\x0d\x73\x59\x2a\x6a\x11\xee\x56\xf3\x7b\x18\xd4\x49\x33\xb3\xfe\xa2\x7a\xa4\x8c\xfd\x37\x5f\x36\x9d\xf1\x2f\x2d\xfc\xdc\x4d\xfe\x24\xbd\x1a\x67\xe9\xbc\x31\x65\x6e\x11\x9f\x47\x03\xd1\x5c\x38\x72\xf8\x6c\x69\x07\xfd\x20\xf0\x0a\xb5\xde\xb7\x36\xd4\x60\xfc\xa9\x0a\x90\xd6\x6a\x2a\x23\xf7\x71\xf7\xe0\xd5\x5e\x51\x87\x2b\x17\xb8\xe3\x02\xbe\x42\x27\x7c\x8f\x0a\xf4\xde\xb1\x1a\xbb\xe5\xcb\x91\xa6\x7f\xb3\x50\xcb\xe8\xac\x43\xc6\x0a\x86\x51\xa0\x65\xbb\xde\x9c\x6e\xe5\xdf\x5c\x36\xca\x13\xd9\x6c\x17\xb2\xfd\x02\xc8\x06\x83\x24\x9a\x04\x00\x00")
+
+func tosca_simple_yaml_1_1Artifact_typesBytes() ([]byte, error) {
+	return bindataRead(
+		_tosca_simple_yaml_1_1Artifact_types,
+		"tosca_simple_yaml_1_1/artifact_types",
+	)
+}
+
+func tosca_simple_yaml_1_1Artifact_types() (*asset, error) {
+	bytes, err := tosca_simple_yaml_1_1Artifact_typesBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "tosca_simple_yaml_1_1/artifact_types", size: 1178, mode: os.FileMode(436), modTime: time.Unix(1792279540, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _tosca_simple_yaml_1_1Capability_types = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xc5\x57\x4d\x6f\xdb\x30\x0c\xbd\xf7\x57\x10\xd8\x75\x0b\xd6\x6b\x0e\x03\xda\x74\xd8\x0a\x6c\x5d\xb1\x74\xbb\x0c\x83\xc1\xc8\x4c\x22\x54\x96\x3c\x89\xee\x96\xfd\xfa\xd1\xb2\xec\xba\xf9\x68\x9c\x34\xc0\x2e\x86\x21\x51\x4f\xfc\x78\x8f\xa6\xd9\x05\x85\x59\x4e\x73\x6d\x35\x6b\x67\x43\xf6\x40\x3e\xc8\xcb\x18\x38\x6e\x05\x5d\x94\x86\xb2\x15\x16\x26\x3b\xcf\xce\xcf\xce\x14\x96\x38\xd3\x46\xf3\x2a\xe3\x55\x49\x61\x7c\x06\x8d\xe9\xa8\xdb\xd1\x14\x46\x17\xcc\xa8\x96\x05\x59\xae\x0d\x00\x72\xf2\xfa\x81\xf2\x6c\xee\x5d\x31\xde\x76\xe0\xab\x73\x7c\xb6\x1d\x6b\xe2\x8a\xb2\x62\x1a\x08\x34\x71\x96\x51\x5b\xf2\xd1\xbc\xf4\xae\x24\x5f\x6f\x34\xc7\x01\x2c\x16\xd4\xbe\xcb\x75\x12\xc3\x18\x02\x7b\x6d\x17\xdd\xa2\xa7\x5f\x95\xf6\x94\x8f\x61\x8e\x26\xd0\x4e\xb7\xd2\x45\x87\x44\xb8\xc3\xa7\xaa\xc8\x54\x59\x85\x75\xbf\xb4\x65\x5a\xa4\x48\xb6\x39\xd6\xae\x2b\xa9\x1c\x7b\x71\x86\x7b\x10\x00\x6f\x60\xe1\x09\x99\x7c\xe6\x7c\x26\x47\xd1\x8c\xe1\x3c\xed\xcb\x75\xe2\xaa\x2c\x92\x55\xab\x8d\x7c\x28\x34\xe8\xdf\x54\x42\x8a\x51\x67\x74\x4a\x2f\xde\x8e\xce\xe1\xc3\xc7\xbf\xc9\x2a\xd7\xe1\x5e\x98\xf6\x97\x9e\xf3\xa3\xde\x3f\xa9\x0b\xf0\xf9\x32\x99\x14\x54\xfc\x9f\xeb\xb7\x13\x4b\x35\x7c\x3f\x98\x60\x49\x27\x3b\x50\xdf\xdb\xbc\x74\xfa\x30\x3d\x6e\x67\xab\xac\xb0\x53\xce\x0c\x56\x11\xfb\xea\x31\x47\xd2\x6c\xb0\x32\x2c\xab\xaa\x6c\x01\x9d\xe7\x75\xb0\xc6\xa5\x1c\x19\x63\x9b\x19\x59\xe2\xdf\xce\xdf\x8f\x6e\xc5\xf6\x8a\xe6\x7b\x4a\x11\x48\x55\x7e\xa3\x9e\x33\xe7\x0c\xa1\xdd\x5b\xc6\xce\xc5\xfe\x72\xe5\x4d\x56\x22\x2f\x0f\xec\x1d\x8f\x11\x66\x47\x74\x9e\xd4\x1e\x9a\xd8\x8f\x07\xe8\x85\x74\xfb\xf5\xfa\xfb\xc5\xdd\xfb\xb4\x11\xdb\x3e\xb2\xf3\x2f\x85\x0d\xae\xf2\x6a\x80\x10\x1e\xd0\xe8\x3c\x93\x67\x25\x7c\x82\x1f\xe9\xdc\x6b\x60\xf4\x0b\xe2\xd7\x50\x12\x79\xf8\xd9\xcb\xda\x46\x4f\x2c\xb0\x3c\x5e\x87\x85\xb6\x99\x21\xbb\x90\x32\x76\x9d\x10\x40\xbe\x52\x7e\x95\x05\xb5\xa4\x02\xfb\xf6\xfb\x99\x38\x2d\x49\xc5\x03\xc8\x92\xb0\x99\xa8\xaf\xbb\x50\x97\x19\xe6\xb9\xa7\x10\x76\xe4\xf6\x79\x99\x8e\x2e\x72\x71\x76\xa0\x58\xdb\x43\xd1\xfa\x15\x4c\x96\x68\x17\x04\xed\x6a\x52\x83\x14\x3b\xd7\xaa\x2e\xb6\x40\x44\x4d\x42\x0d\x08\x9a\x43\x5b\x47\x70\xf3\x5e\x26\x37\x95\x3f\x4c\x56\x8f\x0a\xef\xeb\x7e\x67\x4d\x52\x43\x8c\xc6\x7b\x72\x72\x25\x45\x98\x61\xa0\x43\xd3\xb2\x07\xf6\xb6\x9a\x19\xad\x8e\xc9\xf5\x66\x8a\xba\xec\xf3\x92\xba\xb4\xf6\x05\x5c\x27\xbf\x0a\xcd\xfe\x5c\xfb\xc0\x50\xc6\xeb\x5b\x23\x98\xbb\xca\xe6\x87\x2b\xff\x51\xe1\xdf\x2e\x3f\x5d\x4f\x06\xe7\xfd\x89\xf9\xdc\x38\x64\x41\x1d\xf7\x60\x83\xf2\xba\xe4\x38\x13\xbe\xeb\x01\x24\x36\x51\x90\x48\x90\x63\x38\x29\x90\x44\x7b\x08\x4b\x57\x99\x1c\x66\x04\x68\x8c\xab\x6d\xf3\x86\x71\x28\xca\x76\x26\x92\x2d\xdd\x07\xd7\xb7\x09\x07\x85\xa8\x18\x82\x53\x3a\xda\xff\xd6\xbc\x8c\xd8\xad\xea\x86\x72\xef\x69\x43\x08\x22\x5e\x19\xaf\x80\xfe\x48\xb9\x74\x3d\x96\xa2\x69\x87\x0f\x19\x7a\x9f\xa6\xf7\x49\xc8\x77\x72\xb7\x8b\xef\x68\xa0\xad\x9f\xa7\x85\x0e\xf2\x3d\x6f\xdc\xbb\xba\x99\x1e\xd7\x3d\xb7\x7a\xb5\x9d\xab\x37\x4d\xf8\x27\x18\x35\x4f\x35\xfe\xb6\x05\xb9\x14\x22\xe0\xcc\x0c\xd5\xe4\x8d\xcb\xf7\x21\x7e\xd2\xf6\xfe\x34\x88\xf5\xd6\xcb\xff\x3f\xbe\x48\x0a\x23\x4b\xa7\x2b\x29\x7a\xf1\xf2\x1a\xa0\x57\x4b\xcd\xa4\x78\x4b\x33\x1d\x44\x9d\x68\x7a\xcc\x41\x99\xb4\x9b\xef\x54\xcd\xed\x63\x00\xda\xdf\xc3\xb5\xb3\x69\xf9\x40\x0a\xd5\x29\x6a\xb3\xb9\x26\xb9\xbb\x2f\xd3\xc9\x05\x78\x31\x80\x49\xf7\xc7\x09\x77\x72\x59\xdd\x4c\xc0\x49\x4b\xf0\xc9\xa8\xfe\x20\xac\x1b\x85\x54\x9f\xd8\x6f\x76\xdc\x3e\xad\xa7\xfb\xe1\x34\x7b\xa6\x9e\xf5\x54\xa1\xa5\xcb\xa2\x55\xb4\xf7\x1f\xae\xeb\x50\xed\xfc\x51\xe0\x9f\x17\x9c\x4e\x0b\xc3\x11\x86\x95\x66\x2a\x63\x02\x2e\xe8\xbf\xf4\x9b\x7f\x8b\x98\xc6\xd3\x95\x10\x00\x00")
+
+func tosca_simple_yaml_1_1Capability_typesBytes() ([]byte, error) {
+	return bindataRead(
+		_tosca_simple_yaml_1_1Capability_types,
+		"tosca_simple_yaml_1_1/capability_types",
+	)
+}
+
+func tosca_simple_yaml_1_1Capability_types() (*asset, error) {
+	bytes, err := tosca_simple_yaml_1_1Capability_typesBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "tosca_simple_yaml_1_1/capability_types", size: 4245, mode: os.FileMode(436), modTime: time.Unix(1792279540, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _tosca_simple_yaml_1_1Data_types = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xcd\x55\x4d\x6f\xdb\x30\x0c\xbd\xe7\x57\xf0\x07\x64\x01\x82\x21\x3b\xf8\x36\xb4\x97\x5e\xb6\x61\xcd\x6d\x18\x04\xcd\xa2\x1d\x21\xd6\xc7\x28\x3a\x43\xfe\xfd\x68\xc7\x4d\x5c\x44\x4d\xdc\x22\x43\xe7\x43\x1c\xf0\xe3\x91\xef\x51\xa2\x39\xa4\x52\x2b\x83\x95\xf5\x96\x6d\xf0\x49\xed\x90\x92\xfc\x29\x80\x7b\x57\xb2\x2e\x36\xa8\xf6\xda\x35\x6a\xa9\x96\xb3\x99\xd1\xac\x15\xef\x23\xa6\x62\x06\xf2\xf4\x61\x8b\xce\xda\x1b\x17\xdf\x43\xe0\x83\x07\xc0\x60\x2a\xc9\x46\xee\xf1\xd6\x1b\x84\xf5\xd7\xc7\xbb\xcf\x40\x12\x02\xf7\x92\x01\x6b\x49\x01\xdd\x34\x10\x78\x83\x34\xb8\x7f\xe9\x84\x27\x77\x12\x14\xb2\x3b\x84\x8a\x82\x9b\x65\x4b\xde\x11\x1a\xf4\x6c\x75\x73\x2a\xdc\xa5\x18\xd5\xe5\x14\xd9\x16\x87\xc0\x48\x21\x22\xb1\x7d\x62\x33\xd8\x38\x94\xa1\x39\x59\xa4\xa4\x24\x16\x90\x98\xac\xaf\x47\x66\xc2\xdf\xad\x95\xea\x05\x54\xba\x49\x78\xf4\x70\xd8\xa2\xef\x55\x9a\x00\x22\xea\xeb\xb6\xe1\x02\xa2\x4e\xe9\x4f\x20\xf3\x1c\xe6\x2a\xc2\x16\xf7\xe9\x3c\xc8\xe9\x38\xa1\x51\x00\x11\x8e\xf6\x2a\x95\x1b\x74\x7a\x8c\xf2\x42\xb1\x36\x21\xbd\x41\x98\xec\xdc\xd6\xd6\xe1\x83\x67\xa4\xdd\x8d\x26\x97\x58\x13\x2b\x16\xd8\xf3\x16\x3b\xab\xf8\x5d\x5e\x15\xa6\xf6\x24\x0a\x7a\xf3\x76\x90\x2c\x53\x8f\x2c\x83\xdd\x2e\xbe\x1c\xde\x0f\xbe\x0a\x37\x21\x3c\xe0\x2a\xaf\xdd\xf5\x93\xf6\x14\x6c\xcd\xd5\x50\x6d\x0c\x61\x4a\x98\x39\x57\x8d\x4d\xfc\xfa\xe3\x73\x51\x95\x6f\x81\xf8\x66\x92\x44\x01\x9b\xa6\x47\x1f\x39\x41\x8c\x57\xe8\xe6\x74\xa9\x06\xed\xfe\x43\x8d\xef\xb1\xca\x4b\x6c\xe5\x12\xd6\x48\x83\xaf\x94\xcf\x00\x93\x16\xe3\xa8\xb5\x0f\x12\xa4\x48\xfb\x5a\xea\xfd\x80\xe5\x1c\x3e\xad\x56\x1f\x57\xf0\xf3\x7a\xd5\xc7\x88\xe5\xfb\xed\xe5\x67\x17\x7b\xb4\x6b\xb9\x1c\xdf\xe1\x2c\xe5\x03\x6d\xd9\x4d\xd6\x28\xf9\x6d\xa5\x17\xa1\xde\x9a\x38\xef\xb2\xe7\x60\x6b\x17\x45\x80\x63\x2b\x9a\x6a\xe4\xcc\xce\xb8\x3c\x91\x29\x5f\x93\x1e\x79\x50\xff\x0c\xbf\x37\x4f\x5a\xf5\x17\x58\xbe\x30\xdc\xe3\x62\x0d\x2d\x95\xf8\x2f\xb8\x1d\x90\xdf\x83\xdb\x5f\x03\x4b\x6f\x57\xfb\x08\x00\x00")
+
+func tosca_simple_yaml_1_1Data_typesBytes() ([]byte, error) {
+	return bindataRead(
+		_tosca_simple_yaml_1_1Data_types,
+		"tosca_simple_yaml_1_1/data_types",
+	)
+}
+
+func tosca_simple_yaml_1_1Data_types() (*asset, error) {
+	bytes, err := tosca_simple_yaml_1_1Data_typesBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "tosca_simple_yaml_1_1/data_types", size: 2299, mode: os.FileMode(436), modTime: time.Unix(1792279540, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _tosca_simple_yaml_1_1Group_types = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x65\x4e\x3b\x0a\xc3\x30\x0c\xdd\x73\x0a\x9d\xc0\x90\x35\x5b\xe9\xd0\xb1\xd0\x64\x37\xc6\x96\x1b\x83\x63\x19\x59\x0d\xe4\xf6\x75\xdc\x84\x0c\x7d\x93\x78\x3f\x3d\xa1\x62\x8d\x76\xe8\x43\x0a\x12\x28\x15\xbd\x22\x97\x7a\x0c\x20\x4d\x2a\x61\xc9\x11\xf5\x66\x96\xa8\x7b\xdd\x77\xdd\x9b\xe9\x93\xb5\x6c\x19\xcb\xd0\x41\x45\xf3\xa9\x46\x17\xf5\x22\x92\x1f\x0d\xe0\xb0\x58\x0e\x59\x5a\xdb\x34\x23\x4c\xcf\xf1\x7e\x83\xc7\xee\x84\xa9\x16\x80\x89\x11\x48\x66\xe4\x3f\xa9\xd4\x34\x87\x15\xc1\x33\x2d\x47\x5f\x48\x82\xec\x8d\x3d\x1f\xef\x18\xc5\x24\x67\xd8\x5d\x4c\x1d\x54\xf3\xc7\x7c\x75\x65\x54\x22\x87\x2a\x06\x8f\x76\xb3\x11\xd5\x99\xec\xbe\x0a\x34\xb2\xc0\x03\x01\x00\x00")
+
+func tosca_simple_yaml_1_1Group_typesBytes() ([]byte, error) {
+	return bindataRead(
+		_tosca_simple_yaml_1_1Group_types,
+		"tosca_simple_yaml_1_1/group_types",
+	)
+}
+
+func tosca_simple_yaml_1_1Group_types() (*asset, error) {
+	bytes, err := tosca_simple_yaml_1_1Group_typesBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "tosca_simple_yaml_1_1/group_types", size: 259, mode: os.FileMode(436), modTime: time.Unix(1792279540, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _tosca_simple_yaml_1_1Interface_types = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x9d\x93\xc1\x6e\xa4\x30\x0c\x86\xef\x3c\x85\x5f\x60\x23\xf5\xda\xdb\xaa\xa7\x3d\x55\xda\xce\x3d\xca\x24\x66\xb0\x14\x62\x94\x78\xa8\x78\xfb\x0d\x01\x66\x19\x4a\xa5\xa8\x37\xc0\xbf\xbf\xff\x8f\x63\x84\x93\x35\xda\x61\x4b\x81\x84\x38\x24\x3d\x62\x4c\xf9\xe1\x15\xa4\x94\x12\xf5\x83\x47\x3d\x99\xde\xeb\x17\xfd\xd2\x34\x14\x04\x63\x6b\x2c\x6a\x99\x06\x4c\xaf\x0d\x2c\x4a\xf5\x28\x24\xf5\x97\x59\xe6\x02\x80\xc3\x48\x23\x3a\xdd\x46\xee\x57\xa4\xc2\x20\x24\x53\x11\xad\x9a\x64\x23\x0d\x52\x5c\x2f\x1d\xc2\xe5\xfd\xe3\xed\x37\xc4\x5c\x87\x3f\x1b\x14\x2e\xd9\x0d\x8c\xf7\xc0\xd2\x61\x5c\x35\x57\x93\xf0\xa0\x49\xab\x27\xcc\x96\xcd\x59\xba\xc0\x0e\x95\xa7\x16\xed\x64\x3d\xaa\x0f\x31\xc1\x99\xe8\xbe\x0f\x7c\x38\x59\xd1\xd9\x88\x46\x70\xe9\x39\x1c\x61\x03\xc2\xc3\x63\x55\x03\x0f\x18\xcd\x2c\x52\x0b\x83\x43\x4b\xb7\x7b\xac\xc7\x6c\x0d\x47\x52\x12\x13\xa5\x96\x52\xc4\x5f\x09\x3c\xd4\x03\x78\x38\xf6\x3b\xf4\x58\x3f\x8f\x45\xbd\x67\x9c\x5d\x54\x44\x5f\xaa\xa9\xa3\x41\xbd\x3d\x0f\xab\xf6\x9e\x86\x88\xfa\x31\x36\x9d\xf8\x1e\xed\x79\xca\xf7\x2d\x4b\x86\xcd\x5d\xbf\xfe\x0f\x3b\x2f\x1c\x2c\x9d\x80\xc1\x0d\x9c\x7d\xd4\x09\x3c\x8f\xf5\x86\xf2\x13\xf8\xd2\x79\x84\x73\x92\x9f\x44\xcf\x6d\x55\xd9\x9f\xf1\xd5\xe1\xbf\xe2\x4f\xd3\x1b\xe7\xaa\x99\x81\x85\xda\x69\x1f\x75\xfe\x45\x81\x5b\x30\x1b\xbc\x7c\xb8\x22\x85\xdb\x4c\x46\x07\x23\x99\x5c\x7d\xda\x90\x87\x6f\xed\xa8\x76\xbe\x7b\x9b\xe2\xbb\x0f\xf2\xd9\x91\xed\x80\x52\x7e\xfb\x04\x33\x1a\xf2\xe6\x9a\x97\xf8\xbb\x08\x0b\x4b\xdb\xce\x84\x1b\xba\xea\x18\xab\x63\xe2\x1e\xf3\x8a\xcc\xbf\x86\x4c\xc0\x11\x8c\x48\xa4\xeb\x5d\x4a\xb0\x5d\xd6\x95\x5f\xf0\x11\x7b\x1e\xeb\x2f\x71\x91\x3f\x4f\x57\x35\xff\x00\x36\x0c\x8f\x81\x0c\x06\x00\x00")
+
+func tosca_simple_yaml_1_1Interface_typesBytes() ([]byte, error) {
+	return bindataRead(
+		_tosca_simple_yaml_1_1Interface_types,
+		"tosca_simple_yaml_1_1/interface_types",
+	)
+}
+
+func tosca_simple_yaml_1_1Interface_types() (*asset, error) {
+	bytes, err := tosca_simple_yaml_1_1Interface_typesBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "tosca_simple_yaml_1_1/interface_types", size: 1548, mode: os.FileMode(436), modTime: time.Unix(1792279540, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _tosca_simple_yaml_1_1Node_types = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xcd\x59\xcd\x6e\xe3\x36\x10\xbe\xfb\x29\x08\xec\xa5\x05\x62\x21\x01\x82\x1e\x7c\x8b\xe3\x6d\xbb\xc0\x26\x0e\xe2\x2c\x7a\x58\x04\x02\x2d\x51\x36\x1b\x8a\x54\x49\xca\x59\xf7\xd4\xd7\xe8\xeb\xed\x93\x74\x48\x89\xb2\xac\x1f\xeb\xc7\x9b\xa0\x7b\xd8\xf5\x4a\xf3\x47\xce\xf7\x0d\x87\x23\x2d\x54\x80\xfd\x90\x44\x94\x53\x4d\x05\x57\xfe\x8e\x48\x05\x3f\x66\x48\xdb\x57\x8a\xc6\x09\x23\xfe\x1e\xc7\xcc\xbf\xf2\xaf\x26\x13\x2e\x42\xe2\xeb\x7d\x42\xd4\x6c\x82\x32\x21\xcf\x3c\x53\xde\x4a\x0b\x89\x37\xc4\x9b\x33\x11\xbc\xe4\xff\x31\x32\x08\x85\x44\xd2\x1d\x09\xfd\x48\x8a\x78\x76\xa4\xf3\x28\x84\xb6\x22\x89\x14\x09\x91\x9a\x66\x66\xcd\x1f\x45\xff\x26\xee\x37\x38\x02\x8f\x33\x04\x8a\x0c\xcb\x69\x0a\xc1\x7a\xe6\x7d\xf1\x3a\x80\xd0\xb5\xc4\x94\x6b\x75\xd0\x41\x68\x8a\x36\x92\x60\x4d\xa4\x2f\xa4\x4f\xfe\x4a\x31\x9b\xa1\x2b\x74\x37\xcf\x45\x76\x82\xa5\x31\xf1\x69\x58\xf3\xa3\x25\xe5\x9b\xe2\xa1\x04\x55\x2a\x49\x38\x43\x11\x66\xca\x79\x55\x1c\x27\x6a\x2b\xf4\x38\xfd\x00\x27\x78\x4d\x19\x2d\x2f\x19\x6b\x8d\x83\x6d\x4c\xb8\xae\x1a\xcc\xf6\xac\xac\xe3\xdd\x14\xc2\x93\x4a\x22\x6e\x45\x9c\xa4\xba\xff\xde\x83\x5b\x49\xd7\xa0\x51\x04\x92\x80\x0e\x6c\x9b\x8f\xc3\x50\x12\xa5\x4e\x2e\x2f\x49\xd7\x8c\x06\xbd\x44\x39\xd1\xaf\x42\xbe\xd4\x84\x62\x9c\x14\x4f\x60\x3d\x72\xef\xab\x60\x4b\x62\x5c\x4e\x65\x79\x1f\x42\xac\xb1\x85\xa0\x97\x5b\xf4\xee\xb3\x7f\x3f\xf1\x48\xb8\xb0\x84\xd4\x6f\xe0\xe8\x01\xcc\x16\x5e\xf2\xbc\x9a\x1c\x14\xae\xa6\x08\xe0\x8f\x99\xaf\xca\x04\xc8\x41\xea\xd2\xb7\xef\x4a\xe8\x41\xc7\xa4\x6a\xd6\x49\xb3\x92\x82\x24\x0c\x5b\x26\x6f\x69\xe2\x14\xcb\xcf\x9c\x1f\xa2\x9e\x44\x49\x4d\x04\x41\x2a\x25\xe1\x01\x80\x00\x7d\xbd\xbc\x40\x5f\xee\xe7\xcb\x2f\xf7\x8b\x8f\x8b\xe7\x56\xb8\x6e\x85\xea\x03\xd4\x5b\xc1\x35\x30\x93\xc8\x42\x74\x87\x19\x0d\x7d\x25\x52\x19\xb8\x5a\x82\xbe\x1e\xad\x51\x44\xfa\x15\x4b\x62\x90\x2c\x38\xec\xc8\xf3\xc4\x25\x2d\x4c\x04\xed\xc5\x8f\x8f\xb9\xa8\x77\x13\xc6\x94\xe7\xf2\x42\xf5\xd0\x5c\x42\x25\x82\xfd\xe2\x9b\xd5\x5e\x69\x12\x3b\xba\x9b\xda\xb3\x66\xa4\x87\x81\x55\x2e\x9a\x4b\xae\x29\x0f\xc1\x5a\x0f\x45\x87\xb2\x39\x68\x58\x03\x35\x66\xe7\x5b\xe9\xdd\x24\x09\xb0\xce\x66\xb5\x37\xcf\x9b\xe1\x7a\x9c\xc5\x4e\x94\xd6\xb3\xd9\x04\xd2\x43\xa0\x8f\x29\xd7\x34\x1e\x86\xd0\xdf\x21\x24\x12\x2e\x79\x11\xe3\x70\x32\x1d\x13\x63\xea\x4a\xcf\x00\x0b\x0e\x3f\xed\x39\xc8\x97\xd6\xb5\xff\x35\x30\xff\x68\x42\x8d\x41\x66\x65\x51\x0b\x28\x74\x6b\xac\xce\x39\xaf\x39\x8e\x49\xc7\x39\x08\x16\x02\x49\x13\x9d\xf5\x17\x5b\x02\xa5\x72\x03\x20\x66\x56\x17\x89\xc8\x3e\x0b\xf3\x50\x4a\x75\xbc\x6a\x16\xb2\x42\x36\x25\x04\xd6\xec\x1a\x25\xfb\x23\xe5\xb0\x16\xb6\x87\x20\x0a\xbb\x48\x11\xb9\xa3\x01\x41\xaf\x94\x31\xc4\x28\x40\x8d\xc3\x1a\x51\x24\xa4\x95\xe9\x38\xf5\x53\x50\x1f\xba\x4c\x61\x7f\xc3\x3a\x8d\x32\xc2\x41\x20\x00\x39\xd9\xa2\x8d\xd7\xc5\x1c\x61\x53\xa3\xa8\x69\x61\x8c\x64\x47\x08\x09\x56\x0a\xc0\x1c\x8e\x0e\xc3\x19\xb0\xde\xcd\x1b\x88\xa0\x1c\xda\x49\xff\xef\x58\x45\x16\xf3\xbb\xd5\xf8\xc2\xd1\xc4\x30\x07\x02\x7f\xcc\x41\xe2\x48\x52\x23\x0f\x84\x39\xae\x08\xd4\x59\x24\x81\x60\x7e\xcf\x04\x37\xa3\xe3\x54\xe2\x8d\xf5\xa6\xec\xdf\xad\x1c\x2b\x06\xb0\xae\xb7\xfb\x82\x8d\x65\x47\x6d\xf4\x43\x98\x87\xd6\x34\x51\x5a\xbd\x53\xeb\xd1\x58\x0a\xd1\x73\x35\xcf\x9f\x05\x0e\xe7\x50\x3f\xa1\x49\x92\x67\x14\x4a\xcc\x36\x42\x52\xbd\x8d\x47\xa6\x57\x41\x4f\x9a\x42\xd8\xe4\x1b\x18\xa6\x86\x87\x98\xb5\x6e\x54\xc0\x28\x19\x86\xf2\x07\xdb\xcf\x4f\xfa\x36\x86\x8d\x19\xff\x95\x09\xdb\x40\xa1\x9f\x3e\x3d\xfc\x9c\xc7\xf0\xfd\x9f\x7f\x15\x12\x3c\x43\x84\xf5\xe1\x0e\x65\x08\x9c\x9b\x1b\x1c\x27\x01\xe0\xe4\x54\x6b\x8d\xab\x5d\xcf\xb0\x93\x7c\x48\x29\x79\x14\xe6\x42\x34\xa8\x47\x6e\xd8\x8c\xdb\x6c\x55\x54\x58\x8c\x03\xf1\x11\xc0\x3c\x16\xd2\x1c\x7d\x38\x44\xeb\x0c\x4d\x61\x79\x61\xaa\x0a\xbb\xca\x1d\xe7\x0c\xe4\xd1\xa4\xb8\xde\x8f\xe6\x76\x84\x53\xa6\x67\xe8\xba\xfb\xea\x9d\x51\x0d\xfe\x4e\x33\x92\x5d\x5f\xa0\x5f\x90\xdb\xa9\x80\x86\x72\xdc\xad\x5b\x63\x09\x57\xee\x64\x94\x32\x54\xfd\xb1\xaa\x1b\xb8\x0f\xbf\xe2\xfd\x58\xf5\x3c\x89\x7e\x8f\x2e\xe9\xb4\x81\xb1\xc3\x0a\xb2\xb1\x95\xc2\x20\x6c\xac\x0d\x17\x82\x55\x19\x63\x20\xd9\xee\x95\xe9\xf7\xfc\x5a\x37\x7e\xd6\xd8\x84\x51\xfe\x32\xe0\x72\xf5\x19\xc4\x9b\x7a\xe0\xf2\x15\xff\x3c\x92\xf5\x99\x85\xb4\xed\x11\x1c\xcc\xf5\x06\xb3\x9d\x9e\x5a\xa6\x0d\xec\xbc\x1c\x33\x18\x73\x4a\x54\xf9\xce\x50\x25\x8c\xb5\x10\x8c\x60\xde\xbf\x4a\x94\x1f\xc3\xbe\x48\xcc\x37\xc4\xb7\x0c\x1e\xb5\x37\x85\x09\xe0\xf1\x08\x03\x0d\x67\xca\xb4\x02\x9e\x8e\x93\xa4\x06\xa1\xfe\xe7\x49\x59\xb5\x38\x56\xa6\xf5\xd9\x40\xcf\x08\x8a\x09\xc1\xf0\x08\x8c\xaa\x89\xa0\x65\x7c\xbb\x5c\xff\x09\x27\xd6\xf9\xf3\xdb\xce\x4a\xf7\x56\x03\xde\x4b\xf4\x9b\x1b\xf0\xc6\xf8\xdb\x9b\x7b\x69\xaa\x48\xf9\xbc\x62\xcc\x2d\xa3\x9a\x16\xb3\xc3\xed\x59\x00\x28\x03\x52\x0e\x69\x38\x6a\x3e\x9e\xa0\xd3\x7a\x5a\xae\x6e\x6f\xd0\x3d\x98\x42\x4f\xe0\x1a\x9a\x4f\x86\x04\xb4\x60\x32\x7f\x63\x3b\xdd\xe2\xb5\xca\x9d\x20\xe3\xa3\x65\x36\x9c\x7d\x17\xe8\x38\x42\x32\xa1\x6e\x08\xc0\x81\x74\x4a\xa2\x69\x6f\x23\xc8\x44\x2a\xfb\x0c\x3b\xcc\xb2\x4e\x34\x93\x21\x49\x20\x3f\xd0\xce\xed\x07\xf4\x92\x85\xcd\xb6\x3b\x6b\x91\x8a\xbe\xb4\x5c\xd8\x30\xd4\x92\xb7\x76\x9a\xa8\xdc\x6a\xe6\x1d\x94\x39\x11\x64\x84\x83\xc3\xbe\xac\x34\xdc\x9c\x70\xfd\xd2\x98\xf9\x3c\xc8\xdb\x38\x3d\x46\x23\x12\xec\x03\x46\x3c\xa7\x57\xab\x07\xd5\x1b\xeb\x19\xb5\xe0\x03\x0a\x45\x0c\xb4\x9a\xaa\x84\x04\x34\x82\xd6\x5f\xe5\xd6\x81\x72\xb9\x79\x94\xb7\xa6\xae\x41\x74\xcf\xdb\x5a\xd6\x63\xf1\xb6\x23\xc3\x0e\x56\xfc\x00\x9e\x1b\xaa\x00\x71\x27\xa7\xe7\xfc\xb7\x85\xe4\xff\x65\x08\x92\x7f\xcd\x19\x37\x07\xa9\xe4\xf4\x0f\xb2\x1e\x33\x38\xae\x27\x14\x0a\xa5\x26\xdf\xb4\x2f\x8b\xea\x74\xfe\xc7\xaf\x24\x19\x55\x2f\xdf\x39\x1d\xb0\x85\x2b\x22\x77\x47\x52\x67\x25\x24\xb3\xf6\xe3\x86\xc8\x1f\xd0\x43\xf6\xf5\xee\x02\x31\xbc\x87\x42\x7f\x5d\x7c\x35\x51\xa5\x29\xd8\x61\xaf\x7b\x5c\x99\x33\x16\xf5\xd2\x38\xfa\xdc\xf2\x86\x63\x9a\x63\x28\x43\x55\xfc\x0f\xd5\x4f\xfa\x4c\xcb\x1e\x00\x00")
+
+func tosca_simple_yaml_1_1Node_typesBytes() ([]byte, error) {
+	return bindataRead(
+		_tosca_simple_yaml_1_1Node_types,
+		"tosca_simple_yaml_1_1/node_types",
+	)
+}
+
+func tosca_simple_yaml_1_1Node_types() (*asset, error) {
+	bytes, err := tosca_simple_yaml_1_1Node_typesBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "tosca_simple_yaml_1_1/node_types", size: 7883, mode: os.FileMode(436), modTime: time.Unix(1792279540, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _tosca_simple_yaml_1_1Policy_types = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xc5\x92\x41\x6a\xc4\x30\x0c\x45\xf7\x39\x85\x4e\x10\x98\x6d\x76\xa5\x07\x68\xe8\xa4\x6b\x63\x6c\x25\x11\x38\x96\x2b\x39\x03\xb9\x7d\x1d\x4f\xcb\x14\x1a\x68\x99\x45\xc7\x2b\xa3\x6f\xfd\xff\x24\x9c\x59\x9d\x35\x1e\x47\x8a\x94\x89\xa3\x9a\x0b\x8a\x96\x4b\x07\xb9\x4a\x4a\x4b\x0a\x68\x36\xbb\x04\x73\x32\xa7\xa6\x49\x1c\xc8\x6d\x26\x6f\x09\xb5\x6b\xa0\x9c\xfa\xb0\xad\x75\x42\x6d\x5f\x99\xf3\x55\x00\xf0\xa8\x4e\x28\xe5\x6a\x38\xcc\x08\xc3\xcb\xf9\xf9\x09\xfa\xea\x01\x43\xf1\x00\x1b\x02\x70\x9e\x51\x7e\x6a\x5a\xfa\x85\x2e\x08\xa3\xf0\xd2\x1c\x65\xf5\xc1\x3a\x5c\x30\x7e\x0b\xdc\x1b\xbc\xd9\x3b\xba\x23\xb2\xbf\x83\xdd\x96\x02\x79\xb6\x19\x48\x61\x55\xf4\xc5\x14\x26\x2e\x5b\x8a\x90\xbe\xd2\x81\xc7\x4f\x83\xc8\xc5\x19\x58\x60\x12\x5e\x93\xee\x42\x2d\xb5\x87\xf8\x67\x67\x03\xc5\xe9\x21\xf0\x7a\xcd\xbe\x17\xfd\x2d\x79\x9b\xf1\x21\xe4\x6b\x8d\xbe\x17\xbc\x47\x19\x59\x16\x1b\xdd\x3f\xd3\x7b\x74\xc1\x0a\x42\xba\x01\x80\xe0\xfb\x4a\x52\xff\x90\x42\xa9\xfe\x3a\xd1\x07\x3e\x26\x32\x10\xb0\x03\x00\x00")
+
+func tosca_simple_yaml_1_1Policy_typesBytes() ([]byte, error) {
+	return bindataRead(
+		_tosca_simple_yaml_1_1Policy_types,
+		"tosca_simple_yaml_1_1/policy_types",
+	)
+}
+
+func tosca_simple_yaml_1_1Policy_types() (*asset, error) {
+	bytes, err := tosca_simple_yaml_1_1Policy_typesBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "tosca_simple_yaml_1_1/policy_types", size: 944, mode: os.FileMode(436), modTime: time.Unix(1792279540, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _tosca_simple_yaml_1_1Relationship_types = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xbd\x54\xc1\x6e\xdb\x30\x0c\xbd\xe7\x2b\xf8\x03\x33\x90\xab\x6f\x5d\x3a\x60\x87\x61\x05\xba\xdc\x8a\x42\x60\x24\x3a\x26\x2a\x4b\x2a\xc5\xa4\xe8\xdf\x57\xb6\x93\x38\x6b\x9b\xb4\x1d\x90\xdd\x6c\xf3\x91\xef\xf1\xd1\xa4\xc6\x6c\xd1\x38\x6a\x38\xb0\x72\x0c\xd9\x6c\x49\x72\x79\xa8\x41\x87\x50\xe6\x2e\x79\x32\xcf\xd8\x79\x33\x37\xf3\xd9\x4c\xc8\xe3\x80\x6c\x39\x19\x7d\x4e\x94\xeb\x19\x8c\xe0\xea\x38\x96\xab\x2b\x55\xb4\x2d\xe5\x65\xec\x11\x00\x8e\x84\xb7\xe4\x4c\x23\xb1\xab\xdf\xcd\xb8\x8d\x51\x07\xe8\x16\x3d\x3b\xa3\x28\x6b\xd2\x1d\x09\xdc\xed\x52\x2c\x26\x5c\xb1\x2f\x72\x69\xcf\xd1\x51\x50\xb8\x1f\x32\x93\xc4\x44\xd2\xc7\x46\x52\x00\x1f\xed\x40\xb1\x7f\x2f\x62\x4b\xc1\x1a\xb2\x0a\x87\xf5\xe1\xa3\x2d\x22\x54\x90\x83\xe6\x09\x09\xf0\x0d\x3a\x0e\xc6\x53\x58\x6b\x5b\xc3\x7c\x17\x71\xb4\x65\x4b\x1f\x54\x14\x7a\xdc\xb0\x90\xab\xa1\x41\x9f\x69\x76\xc2\xa6\x45\x0c\x81\xac\x5e\xd4\xa6\x1f\xc1\xa5\xc8\x67\x4c\xb2\x45\x67\x31\x91\xd1\xbf\x6e\x6a\xac\xe6\x50\x71\x20\xa8\x16\x07\xe4\x57\x1b\xbd\xa6\x44\xc1\xe5\x9b\x70\xb9\x3e\x7f\x47\x47\xa5\xc7\x13\x02\x7e\xc6\xac\xe4\x2e\xc9\x5f\x66\xa9\xe5\x17\x22\x39\x2d\x22\x90\x3e\x45\x79\xa8\xbe\x73\xf1\xe2\xf3\x33\x3f\x98\xf7\x35\x41\xc7\x6c\xb8\xf2\xf4\xb1\xae\x5f\x1c\x1e\xfe\x9f\xae\x9e\xed\xbc\xae\x7e\x08\x7b\x31\xd9\x0a\xa7\x61\x97\x61\xd9\x12\x2c\x6f\xfe\x2c\xae\x40\x0a\x00\x6e\x8f\x72\x60\x59\x88\x01\xbd\x87\xa8\x6d\x19\xc5\x08\x5b\x61\xa6\xb7\xb0\xbc\x6b\x11\xfa\x0e\x07\x16\xd4\xb2\xc5\xab\x8d\x4e\xbb\x31\x1e\x42\x76\x67\xd7\x7d\x04\x05\xec\xce\x5d\x85\xb2\x81\x24\x0d\xda\xa9\x76\xf9\x61\x1a\x5e\x6f\x84\xde\x5f\xbb\x29\xe1\x2f\x57\xaa\x43\xda\x69\xd7\xfa\x0e\x3e\x3f\xc6\xe9\x08\xfd\xf3\x61\x79\x01\xb7\xfb\x37\x15\x4b\x06\x00\x00")
+
+func tosca_simple_yaml_1_1Relationship_typesBytes() ([]byte, error) {
+	return bindataRead(
+		_tosca_simple_yaml_1_1Relationship_types,
+		"tosca_simple_yaml_1_1/relationship_types",
+	)
+}
+
+func tosca_simple_yaml_1_1Relationship_types() (*asset, error) {
+	bytes, err := tosca_simple_yaml_1_1Relationship_typesBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "tosca_simple_yaml_1_1/relationship_types", size: 1611, mode: os.FileMode(436), modTime: time.Unix(1792279540, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _tosca_simple_yaml_1_2Artifact_types = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xad\x93\x4f\x6b\xc3\x30\x0c\xc5\xef\xf9\x14\x3a\x6e\x87\x65\x6c\xc7\xdc\xba\x8d\x41\x0f\x65\x63\xed\x7a\x19\xc3\x78\x89\xd2\x08\x1c\xdb\xd8\x6a\x69\xbe\xfd\x1c\xa7\x7f\xe8\xf0\x68\x0a\xcd\xc9\xa0\xa7\x9f\xde\x13\x0a\x1b\x5f\x4a\x51\x61\x4d\x9a\x98\x8c\xf6\x62\x83\xce\x87\x47\x01\x1c\x4b\x9e\x5a\xab\x50\x74\xb2\x55\xe2\x41\x3c\x66\x99\x74\x4c\xb5\x2c\x59\x70\x67\xd1\x17\x19\x84\x2f\x4a\xf3\x7d\xc5\xe7\x1f\xc6\xf0\x50\x01\xa8\xd0\x97\x8e\x2c\x47\xe6\xa2\x41\x58\xbc\xcd\x9f\x27\x30\xd9\x89\x61\x11\x30\x20\x95\x02\xc3\x0d\xba\x54\xd5\x07\x86\xa3\x0d\x42\xed\x4c\x9b\x25\x07\xbe\x92\xc2\xe3\xc0\x5e\x5c\x89\x5e\x5d\x24\xad\xa5\x19\x2f\x68\x95\xe9\x5a\xd4\x7c\x01\x29\x95\x31\x26\xf8\x91\x1e\xa1\x5f\x11\xd4\xc6\x05\xc1\x9e\x0d\x07\xc0\x39\x17\xf9\xb4\x95\xab\x91\xa9\x8e\x5d\xe3\xa8\xf9\x72\x76\x29\x78\x68\x4c\x05\x5e\x92\xe3\xb5\x54\x30\x93\x65\x43\x1a\xe1\x66\x39\xbb\x85\x41\x9d\x34\x33\xed\x2f\xaa\x47\xca\xd8\x7f\xf5\x65\xd3\x09\xff\xdc\xc2\x4f\xdd\xe4\x4f\xd2\x37\xe3\x2c\x9d\x36\xa6\xcc\xcd\xe3\xf3\x60\x20\x9a\x0b\x47\x0e\x9f\x9a\xb6\xd0\x0f\x02\xdf\xa0\x52\xbb\xd6\x96\x5a\x8c\x3f\x55\x01\xd2\x5a\x45\x65\xe4\xde\x6f\xef\x7c\xb3\x53\xd4\xe1\xca\x05\x6e\xb9\x80\xaf\xd0\x09\xdf\xa3\x02\xbd\x77\xdc\x8c\xdd\xf2\xf9\x48\x93\xbf\x59\x48\x33\x3a\xeb\x90\xb1\x82\x61\x14\x28\xa9\x57\xeb\xe3\xad\xfc\x9b\xcb\x46\x79\x22\x9b\xed\x42\xb6\x5f\x57\x67\xbc\xe9\x9a\x04\x00\x00")
+
+func tosca_simple_yaml_1_2Artifact_typesBytes() ([]byte, error) {
+	return bindataRead(
+		_tosca_simple_yaml_1_2Artifact_types,
+		"tosca_simple_yaml_1_2/artifact_types",
+	)
+}
+
+func tosca_simple_yaml_1_2Artifact_types() (*asset, error) {
+	bytes, err := tosca_simple_yaml_1_2Artifact_typesBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "tosca_simple_yaml_1_2/artifact_types", size: 1178, mode: os.FileMode(436), modTime: time.Unix(1792279540, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _tosca_simple_yaml_1_2Capability_types = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xc5\x57\x4d\x6f\xdb\x38\x10\xbd\xe7\x57\x0c\xd0\x6b\x6b\x34\x7b\xf4\xa1\x40\xe2\x04\xdd\x00\x69\x12\xd4\xd9\xbd\x2c\x16\xc2\x98\x1a\xdb\x44\x28\x52\x4b\x8e\xd2\xba\xbf\x7e\x47\x14\xa5\x28\xfe\x88\x65\xc7\x40\x2f\x82\x40\x0e\x1f\xe7\xe3\xbd\xd1\x88\x5d\x50\x98\xe5\x34\xd7\x56\xb3\x76\x36\x64\xcf\xe4\x83\xbc\x8c\x81\xe3\x56\xd0\x45\x69\x28\x5b\x61\x61\xb2\xf3\xec\x8f\xb3\x33\x85\x25\xce\xb4\xd1\xbc\xca\x78\x55\x52\x18\x9f\x41\x63\x3a\xea\x76\x34\x85\xd1\x05\x33\xaa\x65\x41\x96\x6b\x03\x80\x9c\xbc\x7e\xa6\x3c\x9b\x7b\x57\x8c\xb7\x1d\xf8\xee\x1c\x9f\x6d\xc7\x9a\xb8\xa2\xac\x98\x06\x02\x4d\x9c\x65\xd4\x96\x7c\x34\x2f\xbd\x2b\xc9\xd7\x1b\xcd\x71\x00\x8b\x05\xb5\xef\x72\x9d\xc4\x30\x86\xc0\x5e\xdb\x45\xb7\xe8\xe9\xbf\x4a\x7b\xca\xc7\x30\x47\x13\x68\xa7\x5b\xe9\xa2\x43\x22\xdc\xe1\x53\x55\x64\xaa\xac\xc2\xba\x5f\xda\x32\x2d\x52\x24\xdb\x1c\x6b\xd7\x95\x54\x8e\xbd\x38\xc3\x3d\x08\x80\x4f\xb0\xf0\x84\x4c\x3e\x73\x3e\x93\xa3\x68\xc6\x70\x9e\xf6\xe5\x3a\x71\x55\x16\xc9\xaa\xd5\x46\x3e\x14\x1a\xf4\x9f\x2a\x21\xc5\xa8\x33\x3a\xa5\x17\x9f\x47\xe7\xf0\xf5\xcf\x5f\xc9\x2a\xd7\xe1\x49\x98\xf6\x8b\xde\xf2\xa3\xde\x3f\xa9\x0b\xf0\xed\x32\x99\x14\x54\xfc\x9e\xeb\xb7\x13\x4b\x35\x7c\x3f\x98\x60\x49\x27\x3b\x50\xaf\x6d\x5e\x3a\x7d\x98\x1e\xb7\xb3\x55\x56\xd8\x29\x67\x06\xab\x88\x7d\xf5\x92\x23\x69\x36\x58\x19\x96\x55\x55\xb6\x80\xce\xf3\x3a\x58\xe3\x52\x8e\x8c\xb1\xcd\x8c\x2c\xf1\x0f\xe7\x9f\x46\x0f\x62\x7b\x45\xf3\x3d\xa5\x08\xa4\x2a\xbf\x51\xcf\x99\x73\x86\xd0\xee\x2d\x63\xe7\x62\x7f\xb9\xf2\x26\x2b\x91\x97\x07\xf6\x8e\x97\x08\xb3\x23\x3a\x4f\x6a\x0f\x4d\xec\xc7\x03\xf4\x42\x7a\xf8\x7e\xf3\xf7\xc5\xe3\x75\xda\x88\x6d\x1f\xd9\xf9\xf7\xc2\x06\x57\x79\x35\x40\x08\xcf\x68\x74\x9e\xc9\xb3\x12\x3e\xc1\x3f\xe9\xdc\x47\x60\xf4\x0b\xe2\x8f\x50\x12\x79\xf8\xb7\x97\xb5\x8d\x9e\x58\x60\x79\xbc\x0e\x0b\x6d\x33\x43\x76\x21\x65\xec\x3a\x21\x80\x7c\xa5\xfc\x2a\x0b\x6a\x49\x05\xf6\xed\xf7\x33\x71\x5a\x92\x8a\x07\x90\x25\x61\x33\x51\x5f\x77\xa1\x2e\x33\xcc\x73\x4f\x21\xec\xc8\xed\xdb\x32\x1d\x5d\xe4\xe2\xec\x40\xb1\xb6\x87\xa2\xf5\x07\x98\x2c\xd1\x2e\x08\xda\xd5\xa4\x06\x29\x76\xae\x55\x5d\x6c\x81\x88\x9a\x84\x1a\x10\x34\x87\xb6\x8e\xe0\xe6\xbd\x4c\x6e\x2a\x7f\x98\xac\x5e\x14\xde\xd7\xfd\xce\x9a\xa4\x86\x18\x8d\xf7\xe4\xe4\x4a\x8a\x30\xc3\x40\x87\xa6\x65\x0f\xec\x43\x35\x33\x5a\x1d\x93\xeb\xcd\x14\x75\xd9\xe7\x25\x75\x69\xed\x0b\xb8\x4e\x7e\x15\x9a\xfd\xb9\xf6\x81\xa1\x8c\xd7\xb7\x46\x30\x77\x95\xcd\x0f\x57\xfe\x8b\xc2\xff\xba\xbc\xbd\x99\x0c\xce\xfb\x2b\xf3\xb9\x71\xc8\x82\x3a\xee\xc1\x06\xe5\x75\xc9\x71\x26\xfc\xd2\x03\x48\x6c\xa2\x20\x91\x20\xc7\x70\x52\x20\x89\xf6\x10\x96\xae\x32\x39\xcc\x08\xd0\x18\x57\xdb\xe6\x0d\xe3\x50\x94\xed\x4c\x24\x5b\xba\x0f\x6e\x1e\x12\x0e\x0a\x51\x31\x04\xa7\x74\xb4\xff\xa1\x79\x19\xb1\x5b\xd5\x0d\xe5\xde\xeb\x86\x10\x44\xbc\x32\x5e\x01\xfd\x94\x72\xe9\x7a\x2c\x45\xd3\x0e\x1f\x32\xf4\xbe\x4e\xef\xab\x90\x1f\xe5\x6e\x17\xdf\xd1\x40\x5b\x3f\x4f\x0b\x1d\xe4\x7b\xde\xb8\x77\x75\x37\x3d\xae\x7b\x6e\xf5\x6a\x3b\x57\xef\x9a\xf0\x4f\x30\x6a\x9e\x6a\xfc\x6d\x0b\x72\x29\x44\xc0\x99\x19\xaa\xc9\x3b\x97\xef\x43\xbc\xd5\xf6\xe9\x34\x88\xf5\xd6\xfb\xff\x3f\xee\x25\x85\x91\xa5\xd3\x95\x14\xbd\x78\x7f\x0d\xd0\xab\xa5\x66\x52\xbc\xa5\x99\x0e\xa2\x4e\x34\x3d\xe6\xa0\x4c\xda\xcd\x77\xaa\xe6\xf6\x31\x00\xed\xef\xe1\xda\xd9\xb4\x7c\x20\x85\xea\x14\xb5\xd9\x5c\x93\xdc\xe3\xfd\x74\x72\x01\x5e\x0c\x60\xd2\xfd\x71\xc2\xa3\x5c\x56\x37\x13\x70\xd2\x12\x7c\x32\xaa\x3f\x08\xeb\x46\x21\xd5\x27\xf6\x9b\x1d\xb7\x4f\xeb\xe9\x7e\x38\xcd\xde\xa8\x67\x3d\x55\x68\xe9\xb2\x68\x15\xed\xfd\x87\xeb\x3a\x54\x3b\x7f\x14\xf8\xf3\x1d\xa7\xd3\xc2\x70\x84\x61\xa5\x99\xca\x98\x80\x0b\xfa\x2d\xfd\xe6\x7f\x28\x01\xdb\x9b\x95\x10\x00\x00")
+
+func tosca_simple_yaml_1_2Capability_typesBytes() ([]byte, error) {
+	return bindataRead(
+		_tosca_simple_yaml_1_2Capability_types,
+		"tosca_simple_yaml_1_2/capability_types",
+	)
+}
+
+func tosca_simple_yaml_1_2Capability_types() (*asset, error) {
+	bytes, err := tosca_simple_yaml_1_2Capability_typesBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "tosca_simple_yaml_1_2/capability_types", size: 4245, mode: os.FileMode(436), modTime: time.Unix(1792279540, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _tosca_simple_yaml_1_2Data_types = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xcd\x55\x4d\x6f\xdb\x30\x0c\xbd\xe7\x57\xf0\x07\x64\x01\xba\x21\x3b\xe4\x56\xb4\x97\x0e\x6b\x3b\x2c\x39\x0c\x18\x06\x41\xb3\x98\x44\x8b\xf5\x31\x8a\xce\x96\x7f\x3f\xda\x71\x93\x74\x51\x12\xb7\xcd\xd0\xf9\x60\x1b\x24\xf5\x1e\xf9\x28\x51\x1c\x52\xa1\x95\xc1\xa9\xf5\x96\x6d\xf0\x49\x2d\x91\x92\xfc\x8c\x80\x1b\x57\xb2\x2e\x96\xa8\x56\xda\x95\xea\x42\xbd\xed\xf5\x8c\x66\xad\x78\x15\x31\x8d\x7a\x20\x4f\x13\x36\xa8\xad\x8d\x71\xf0\x39\x04\x5e\x7b\x00\x0c\xa6\x82\x6c\xe4\x06\x6f\x32\x47\x98\xdc\x8f\xaf\x2e\x81\x24\x04\xae\x65\x05\x4c\x64\x09\xe8\xb2\x84\xc0\x73\xa4\xd6\xfd\x5d\x27\xdc\xba\x93\xa0\x90\x5d\x22\x4c\x29\xb8\x5e\x96\xf2\x47\x12\xfc\x0d\x65\x1d\x6c\x54\x1d\x3d\x82\xc4\x64\xfd\x2c\x97\xcd\x65\xeb\x83\x79\x28\x4d\xfd\xd5\xf0\x61\x7c\x7f\x07\x26\x14\x95\x43\xcf\x79\xa6\xdf\xae\x7c\x39\x91\x87\x2f\xb7\x1f\x4f\x10\x5d\x11\x1a\x71\x5a\x7d\x80\x2f\xa7\x7a\x1b\x18\x29\x44\x24\xb6\x0f\x0d\x6a\x6d\x1c\x8a\x50\x6e\x2d\x42\x29\x0b\xff\x4a\xbc\x7e\x08\x7f\x56\x56\xd8\x47\x30\xd5\x65\xc2\x8d\x87\xc3\x02\x7d\xd3\xf8\x0e\x20\xb2\xa1\x74\x55\xf2\x08\xa2\x4e\xe9\x57\x20\xf3\x18\xe6\x24\xc2\x02\x57\x69\x3f\xc8\xe9\xd8\x21\x51\x00\x11\x8e\x56\x2a\x15\x73\x74\x7a\x17\xe5\x00\x59\x95\x90\x9e\x21\x4c\xb6\x6f\x13\xeb\xf0\xc6\x33\xd2\xf2\x4c\x9d\x4b\xac\x89\x15\x0b\xec\x7e\x8a\xb5\x55\xfc\x2e\xaf\x0a\x53\xb5\x15\x05\xbd\x79\x3e\x48\xb6\x52\x8f\x2c\x8d\x5d\x0c\xee\xd6\xdf\x1b\x3f\x0d\x67\x29\xb8\xc5\x55\x5e\xbb\xd3\x3b\xed\x21\xd8\x9a\x93\xa1\xda\x18\xc2\x94\x30\xb3\xaf\x4a\x9b\xf8\xe9\xdb\xe7\xa8\x2a\x9f\x02\xf1\xd9\x24\x89\x02\xd6\x4d\x8f\x26\xb2\x83\x18\x4f\xd0\xcd\xe9\x42\xb5\xda\xfd\x87\x1a\x5f\xe3\x34\x2f\xb1\x95\x43\x38\x43\x6a\x7d\x85\xdc\x6c\x4c\x5a\x8c\x3b\xa9\xbd\x91\x20\x45\xda\xcf\x84\xef\x2b\x5c\xf4\xe1\xfd\x70\xf8\x6e\x08\xdf\x4e\xb3\x8e\x23\x16\xaf\x37\x97\x1f\x1d\xec\x9d\x59\xcb\xc5\xee\x19\xce\x96\xbc\x2e\x5b\x66\x93\x35\x4a\xde\x95\xe4\x22\xa5\x57\x26\xf6\xeb\xd5\x7d\xb0\x33\x17\x45\x80\x4d\x2a\x9a\x66\xc8\x99\x99\x71\xbc\x23\x5d\x6e\x93\x06\xb9\x55\x7f\x0f\xbf\x31\x77\x1a\xf5\x47\xaa\x3c\xd0\xdc\xcd\x60\x0d\x15\x15\xf8\x2f\x6a\x5b\x23\xbf\x46\x6d\x7f\x00\x02\x78\xb3\x1f\xce\x09\x00\x00")
+
+func tosca_simple_yaml_1_2Data_typesBytes() ([]byte, error) {
+	return bindataRead(
+		_tosca_simple_yaml_1_2Data_types,
+		"tosca_simple_yaml_1_2/data_types",
+	)
+}
+
+func tosca_simple_yaml_1_2Data_types() (*asset, error) {
+	bytes, err := tosca_simple_yaml_1_2Data_typesBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "tosca_simple_yaml_1_2/data_types", size: 2510, mode: os.FileMode(436), modTime: time.Unix(1792279540, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _tosca_simple_yaml_1_2Group_types = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x65\x4e\x3b\x0a\xc3\x30\x0c\xdd\x73\x0a\x9d\xc0\xd0\x8e\xd9\x4a\x87\x8e\x85\x26\xbb\x31\xb6\xdc\x08\x1c\xdb\xc8\x6a\x20\xb7\xaf\xe3\x26\x64\xe8\x9b\xc4\xfb\xe9\x49\x2a\xd6\x68\x87\x9e\x22\x09\xa5\x58\xf4\x82\x5c\xea\xd1\x83\x34\xa9\xd0\x9c\x03\xea\xd5\xcc\x41\x5f\xf4\xb5\xeb\xde\x9c\x3e\x59\xcb\x9a\xb1\xf4\x1d\x54\x34\x9f\x6a\x74\x51\xaf\x94\xe4\x47\x03\x38\x2c\x96\x29\x4b\x6b\x1b\x27\x84\xf1\x39\xdc\x6f\xf0\xd8\x9c\x30\xd6\x02\x30\x21\x40\x92\x09\xf9\x4f\x2a\x35\xcd\xb4\x20\x78\x4e\xf3\xde\x47\x51\x90\xbd\xb1\xc7\xe3\x0d\x83\x98\xe8\x0c\xbb\x93\xa9\x83\x6a\x7e\x9f\xaf\xce\x8c\x8a\xc9\xa1\x0a\xe4\xd1\xae\x36\xa0\x3a\x92\xdd\x17\x17\x3a\xf2\x55\x03\x01\x00\x00")
+
+func tosca_simple_yaml_1_2Group_typesBytes() ([]byte, error) {
+	return bindataRead(
+		_tosca_simple_yaml_1_2Group_types,
+		"tosca_simple_yaml_1_2/group_types",
+	)
+}
+
+func tosca_simple_yaml_1_2Group_types() (*asset, error) {
+	bytes, err := tosca_simple_yaml_1_2Group_typesBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "tosca_simple_yaml_1_2/group_types", size: 259, mode: os.FileMode(436), modTime: time.Unix(1792279540, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _tosca_simple_yaml_1_2Interface_types = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x9d\x93\xc1\x6e\xa4\x30\x0c\x86\xef\x3c\x85\x5f\x60\x23\xed\x1e\x7b\x5b\xf5\xb4\xa7\x4a\xdb\xb9\x47\x99\xc4\x0c\x91\x42\x8c\x1c\x0f\x15\x6f\xbf\x21\xc0\x2c\x43\xa9\x14\xf5\x06\xf8\xf7\xf7\xff\x71\x8c\x50\xb2\x46\x3b\x6c\x7d\xf4\xe2\x29\x26\x3d\x22\xa7\xfc\xf0\x02\x52\x4a\xc9\xf7\x43\x40\x3d\x99\x3e\xe8\x9f\xfa\x57\xd3\xf8\x28\xc8\xad\xb1\xa8\x65\x1a\x30\xbd\x34\xb0\x28\xd5\xa3\x90\xd4\x5f\x22\x99\x0b\x00\x0e\xd9\x8f\xe8\x74\xcb\xd4\xaf\x48\x85\x51\xbc\x4c\x45\xb4\x6a\x92\x65\x3f\x48\x71\xbd\x74\x08\x97\xb7\xf7\xd7\xdf\xc0\xb9\x0e\x7f\x36\x28\x5c\xb2\x1b\x98\x10\x80\xa4\x43\x5e\x35\x57\x93\xf0\xa0\x49\xab\x27\xcc\x96\xcd\x59\xba\x48\x0e\x55\xf0\x2d\xda\xc9\x06\x54\xef\x62\xa2\x33\xec\xbe\x0e\x7c\x38\x59\xd1\x59\x46\x23\xb8\xf4\x1c\x8e\xb0\x01\xe1\xe1\xb1\xaa\x81\x06\x64\x33\x8b\xd4\xc2\xa0\xd8\xfa\xdb\x9d\xeb\x31\x5b\xc3\x91\x94\xc4\xb0\xd4\x52\x8a\xf8\x33\x81\x86\x7a\x00\x0d\xc7\x7e\x87\x01\xeb\xe7\xb1\xa8\xf7\x8c\xb3\x8b\x62\x0c\xa5\x9a\x3a\x3f\xa8\xd7\xe7\x61\xd5\xde\xd3\xc0\xa8\x1f\x63\xd3\x89\xee\x6c\xcf\x53\xbe\x6d\x59\x32\x6c\xee\xfa\xf1\x7f\xd8\x79\xe1\x60\xe9\x04\x8c\x6e\xa0\xec\xa3\x4e\xe0\x79\xac\x37\x94\xef\xc0\x97\xce\x23\x9c\x92\x7c\x27\x7a\x6e\xab\xca\xfe\x8c\xaf\x0e\xff\x19\x7f\x9a\xde\x38\x57\xcd\x8c\x24\xbe\x9d\xf6\x51\xe7\x5f\x14\xa8\x05\xb3\xc1\xcb\x87\x2b\xfa\x78\x9b\xc9\xe8\x60\xf4\x26\x57\x9f\x36\xe4\xe1\x5b\x3b\xaa\x9d\xef\xde\xa6\xf8\xee\x83\x7c\x74\xde\x76\xe0\x53\x7e\xfb\x00\x33\x1a\x1f\xcc\x35\x2f\xf1\x57\x11\x16\x96\xb6\x9d\x89\x37\x74\xd5\x31\x56\xc7\x44\x3d\xe6\x15\x99\x7f\x0d\x99\x80\x18\x8c\x08\xfb\xeb\x5d\x4a\xb0\x5d\xd6\x95\x5f\xf0\x8c\x3d\x8d\xf5\x97\xb8\xc8\x9f\xa7\xab\x9a\x7f\x28\x06\x27\xe7\x0c\x06\x00\x00")
+
+func tosca_simple_yaml_1_2Interface_typesBytes() ([]byte, error) {
+	return bindataRead(
+		_tosca_simple_yaml_1_2Interface_types,
+		"tosca_simple_yaml_1_2/interface_types",
+	)
+}
+
+func tosca_simple_yaml_1_2Interface_types() (*asset, error) {
+	bytes, err := tosca_simple_yaml_1_2Interface_typesBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "tosca_simple_yaml_1_2/interface_types", size: 1548, mode: os.FileMode(436), modTime: time.Unix(1792279540, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _tosca_simple_yaml_1_2Node_types = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xcd\x59\xcd\x6e\xe3\x36\x10\xbe\xfb\x29\x08\xec\xa5\x05\x62\x63\xb7\x08\x7a\xf0\x2d\x8e\xb7\xed\x02\x9b\x38\x88\xb3\xe8\xa1\x08\x04\x9a\xa2\x6d\x36\x14\xa9\x92\x94\x13\xf5\xd4\xd7\xd8\xd7\xeb\x93\x74\x48\x89\xb2\xac\x1f\x5b\x92\x93\xa0\x7b\xd8\xf5\x4a\xf3\xcb\x99\x6f\x66\x38\x32\x52\x13\x1c\x84\x74\xcd\x04\x33\x4c\x0a\x1d\xec\xa8\xd2\xf0\x63\x8a\x8c\x7b\xa5\x59\x14\x73\x1a\xa4\x38\xe2\xc1\xa7\xe0\xa7\xd1\x48\xc8\x90\x06\x26\x8d\xa9\x9e\x8e\x50\x46\x34\xb1\xcf\xf4\xe4\x6a\xa5\x8d\xc2\xc4\x4c\xae\x65\x14\x27\x86\xda\xf7\x08\x85\x54\xb1\x1d\x0d\x83\xb5\x92\xd1\xf4\x80\xfe\x5e\x4a\xe3\x48\x08\x8e\xf1\x8a\x71\x30\x20\x13\x6a\xff\x6c\xa5\x36\xfe\x37\xa8\x01\x7d\x9e\xb9\x4c\xed\x55\x15\x84\x3b\xcc\x59\x18\x68\x99\x28\xe2\xad\x44\x7f\x3c\x8e\xda\x2c\x5d\x1a\xa9\xf0\xa6\xbb\xa5\xb1\x92\x31\x55\x65\x3b\x05\x8e\x68\xd5\x4e\x10\xce\xc4\x26\x7f\xa8\xd9\xdf\x75\x02\x82\x39\x56\xe3\x04\xce\x7c\x62\xdf\x17\xaf\x21\x10\x38\xe1\x66\x8a\x3e\xa2\x9b\x59\xf1\x94\x40\x5c\xc0\x5e\x26\x8c\xde\x4b\x42\x68\x8c\x36\x8a\x62\x43\x55\x20\x55\x40\xff\x4a\x30\xcf\xf9\x2a\xde\xe6\x4e\x4e\x66\x5c\x92\xa7\x8e\x1e\x57\x4f\xa8\xc5\xfb\x5e\xce\xf5\x70\xe3\xd3\xde\xfd\x9d\xe4\x49\x44\x03\x16\x1e\x3d\x65\x84\x14\xb0\x32\x45\xc3\x29\x5a\x63\xae\xbd\x56\x2d\x70\xac\xb7\xd2\x0c\xe3\x6f\x4a\x4c\x6c\x0c\x26\xdb\x88\x8a\x2e\xe9\x79\x55\x10\x57\x83\xd2\x11\x23\x55\x4c\x8d\x72\x13\x14\x5b\xc1\xff\x0a\xa3\x62\xe0\x87\x23\x0c\x70\x18\x2a\xaa\xf5\x51\x57\xe3\x64\xc5\x19\xe9\x44\x2a\xa8\x79\x96\xea\xa9\x46\x14\xe1\xb8\x78\x02\xbe\xa9\x34\xd0\x64\x4b\x23\x5c\x0e\x6b\xf9\x4c\x42\x6c\xb0\x03\xe3\x24\x97\x38\xb9\xcd\xfe\xfd\x22\xd6\xd2\x9b\x25\x95\x79\x03\x45\x77\x20\xb6\xd0\x92\xc7\xd8\xc6\xa3\x50\x35\x46\x00\x0b\xcc\x03\x5d\x06\x46\x9e\xb0\x3e\x94\xe9\xa9\xe0\xee\x79\x6c\xd8\xa6\x27\xe1\x57\x62\x50\x94\x63\x57\x79\xb7\x2c\xf6\x8c\xe5\x67\x5e\x0f\xd5\x0f\xb2\xc4\x26\x09\x49\x94\xa2\x82\xb8\x0a\xf7\xf1\x02\x7d\xbb\x9d\x2d\xbe\xdd\xce\x3f\xcf\x1f\xdf\xa5\xa6\x1e\x78\x28\xd7\xe6\x19\x2b\x6a\x19\xa5\x80\xf3\x78\x1c\xf9\x90\x85\xb1\x64\x9d\x90\xf2\x39\x27\x9d\x5c\x85\x11\x13\x39\xbd\xd4\x1d\x38\x17\x50\x93\xe0\xb4\xc4\x66\x99\x6a\x43\x23\x0f\x7c\x5b\x85\x56\x9c\x76\x10\xb0\xcc\x49\x73\xca\x15\x13\x21\x48\xeb\xc0\xe8\x73\x6c\x06\x1c\x4e\x40\x0d\xe3\xc2\x40\xb9\xa3\x6a\x72\x15\xc7\x80\x39\x17\xd3\xce\xbd\xa6\x39\x59\x0f\x63\x78\x32\x47\x0b\x13\x8e\xa6\xe8\xde\xd0\xfb\x44\x18\x16\xf5\xcb\xcf\xdf\xc0\x24\x1a\x2e\x44\x61\x63\x7f\x28\x1d\xc2\x62\xec\x0b\x4f\x0f\x09\x3e\x7f\xda\x63\x90\xbb\x76\xea\xfc\x6b\xc9\x7c\x3e\x9c\x0e\x43\x30\x24\x33\x2b\x4e\xcd\xa1\xcc\xad\xb0\x7e\xd3\xb9\xc5\xca\xd5\x44\xb1\xd8\x64\xd3\xe0\x96\x42\xa1\xdc\x40\x12\x73\xc7\x8b\xe4\xda\x3d\x0b\x73\x53\x4a\x55\xbc\x2a\x16\xa2\x42\x37\xa5\x0c\xac\xc9\xb5\x4c\xee\x47\x22\xc0\x17\x9e\x82\x11\x85\x5c\xa4\xa9\xda\x31\x42\xd1\x33\xe3\x1c\x71\x06\xa9\x26\xc0\x47\xb4\x96\xca\xd1\x9c\xe8\xff\x09\xb0\xf7\x75\x53\xba\xdf\xe0\xa7\x65\x46\x98\x10\x09\x99\x93\x39\x6d\xb5\xce\x67\x08\xdb\x1a\xc5\x6c\x67\xb6\x94\x27\x4c\x88\xb1\xd6\x90\xcc\xe1\x60\x33\xbc\x00\xa7\xdd\xbe\x01\x0b\xca\xa6\x1d\xd5\xff\x8e\x55\x64\x3e\xbb\x59\x0e\x2f\x1c\x4d\x08\xf3\x49\x10\x0c\x69\x24\x1e\x24\x35\xf0\x80\x99\xc3\x8a\x40\x1d\x45\x0a\x00\x16\x74\x0c\x70\x73\x76\x1c\x0b\xbc\x95\xde\x14\xfd\x9b\xa5\x47\x45\x0f\xd4\x75\x56\x5f\xa0\xb1\xac\xa8\x0d\x7e\x08\x8b\xd0\x89\xa6\xda\xe8\xd7\xae\x94\x2d\xa3\x47\x63\x29\x44\xb5\x4b\xde\x57\x89\xc3\x19\xd4\x4f\x18\x91\xd4\x19\x85\x12\xf3\x8d\x54\xcc\x6c\xa3\x81\xe1\xd5\x30\x91\x26\x60\x36\x7d\x01\xc1\xcc\xe2\x10\xf3\xd6\x83\x22\x9c\xd1\x7e\x59\x7e\xe7\xa6\xf9\x51\xd7\xb1\xb0\x31\xe2\xbf\x70\xe9\x06\x28\xf4\xc3\x97\xbb\x1f\x73\x1b\xfe\xfd\xe7\xbb\x46\x52\x64\x19\xe1\x74\xf8\xa6\x0c\x86\x0b\x7b\x97\x13\x94\x40\x9e\x1c\x1b\xac\x71\x75\xea\xe9\xd7\xc9\xfb\x94\x92\x7b\x69\xaf\x43\xbd\x26\xe4\x86\xc3\xb8\xce\xbc\x62\xd2\xe5\x38\x00\x1f\x41\x9a\x47\x52\xd9\xd6\x87\x43\xb4\xca\xb2\x29\x2c\x3b\xa6\xab\x69\x57\xb9\xe1\x9c\x91\x79\x2c\x2e\x96\x31\x83\xb1\x9d\xaf\x13\x2e\x4f\x5f\xc2\x33\xa8\xc1\xdf\x49\x06\xb2\xcb\x0b\xf4\x33\xf2\x27\x45\x58\xa8\x86\xdd\xbf\x0d\x56\x70\xf9\x8e\x07\x31\x43\xd5\x1f\xca\xba\x81\xdb\xf0\x33\x4e\x87\xb2\xe7\x41\x0c\x3a\x4c\x49\xc7\x05\x0c\x5d\x5b\xd0\x8d\xab\x14\x36\xc3\x86\xca\xf0\x26\x38\x96\x21\x02\xe2\x6d\xaa\xed\xbc\x17\xd4\xa6\xf1\xb3\x16\x28\x9c\x89\xa7\x1e\x97\xab\xaf\x40\xde\x34\x03\x97\x2f\xf8\xe7\x81\xac\xcb\x26\xa4\xed\x8c\xa0\x31\xd7\x07\xcc\x76\x78\x1a\x95\x34\x2d\xfb\x06\x6d\xfa\xbc\x03\x3a\xf0\x82\x2a\x66\xac\xa4\xe4\x14\x8b\xee\x55\xa2\xfc\x18\xce\x45\x61\xb1\xa1\x81\x43\xf0\xa0\xb3\x29\x44\x00\x8e\x07\x08\x68\xe8\x29\xe3\x4a\xf2\x9c\xe8\x24\xb5\x14\xea\xde\x4f\xca\xac\x45\x5b\x19\xd7\x77\x03\x1d\x2d\x28\x36\x04\xfd\x2d\xb0\xac\xd6\x82\x96\xa5\xee\x62\xf5\x27\x74\xac\xd7\xdd\xea\xbe\xee\x4e\xbb\xd7\xf6\xfa\x57\xbf\xf6\x8d\xf0\xcb\x9b\x6b\x69\xaa\x4e\xf9\xee\x62\xc8\x8d\xa3\x1a\x22\x5b\x75\xda\x23\x02\x69\x0d\x59\xb3\x2f\x4d\x07\x83\xc8\x03\x4c\x5d\x0f\x8b\xe5\xf5\x15\xba\x05\x51\xe8\x01\x54\xc3\x20\xca\x91\x84\x71\x4c\xe5\x6f\xdc\xd4\x5b\xbc\xd6\xb9\x12\x64\x75\xb4\x6c\x89\xb3\x2f\x3a\x27\xda\x49\x46\x74\x3a\x05\xa0\x39\x1d\xa3\x68\x3a\xdb\x35\x44\x22\x51\x5d\x16\x1f\xd6\xad\x23\x83\x65\x48\x63\x88\x0f\x8c\x76\x69\x8f\xb9\xb2\x90\xd9\x76\x7f\x2d\x42\xd1\x15\xa2\x73\x67\x86\x5e\x88\xd6\xa9\x13\x95\xc7\xce\x7c\x9a\xb2\xdd\x41\xad\x31\xd9\x9f\xcb\xd2\xc0\x2d\x0a\xd7\x2f\x90\x99\xce\x3d\xbd\xb3\x73\xc2\xd9\x9a\x92\x94\x70\x3a\xf1\x7c\xb5\xda\x50\xbd\xbd\x9e\xd1\x1f\x3f\xa0\x50\x46\x00\xab\xb1\x8e\x29\x61\x6b\xb8\x06\xe8\x5c\x3a\x40\x2e\x17\x8f\xf2\x31\xd5\x0f\x8b\xfe\x79\xdb\xf8\x7a\x48\xde\xd6\x3e\xdc\x92\x25\x20\xf0\xdc\x42\x05\x80\x3b\x3a\xbe\xf1\xbf\x2e\x28\xff\x2f\x0b\x91\xea\x22\xbd\xd7\x4e\xa4\x12\xd3\xdf\xe9\x6a\xc8\x12\xb9\x1e\x50\x28\x94\x86\xbe\x98\x40\x15\xd5\xe9\xfc\x4f\x62\x71\x3c\xa8\x5e\xbe\x73\x38\xe0\x08\x97\x54\xed\x0e\xa8\xce\x0a\x48\x26\xed\xf5\x16\xca\x1f\xd0\x5d\xf6\x1d\xef\x02\x71\x9c\x42\xa1\xbf\x2c\xbe\xa0\xe8\xd2\x46\x6c\x7f\xd6\x1d\xae\xcf\x19\x8a\x3a\x71\x1c\x7c\x7a\x79\xc3\x95\xcd\x61\x2a\x43\x55\xfc\x0f\x78\x30\xa0\x68\x85\x20\x00\x00")
+
+func tosca_simple_yaml_1_2Node_typesBytes() ([]byte, error) {
+	return bindataRead(
+		_tosca_simple_yaml_1_2Node_types,
+		"tosca_simple_yaml_1_2/node_types",
+	)
+}
+
+func tosca_simple_yaml_1_2Node_types() (*asset, error) {
+	bytes, err := tosca_simple_yaml_1_2Node_typesBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "tosca_simple_yaml_1_2/node_types", size: 8325, mode: os.FileMode(436), modTime: time.Unix(1792279540, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _tosca_simple_yaml_1_2Policy_types = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xc5\x92\x41\x6a\xc4\x30\x0c\x45\xf7\x39\x85\x4e\x10\x68\x97\xd9\x95\x1e\xa0\xa1\x93\xae\x8d\xb1\x95\x44\xe0\x58\xae\xe4\x0c\xe4\xf6\x75\x3c\x2d\x53\x68\xa0\x65\x16\x1d\xaf\x8c\xbe\xf5\xff\x93\x70\x66\x75\xd6\x78\x1c\x29\x52\x26\x8e\x6a\xce\x28\x5a\x2e\x1d\xe4\x2a\x29\x2d\x29\xa0\xd9\xec\x12\xcc\x83\x79\x6c\x9a\xc4\x81\xdc\x66\xf2\x96\x50\xbb\x06\xca\xa9\x0f\xdb\x5a\x27\xd4\xf6\x95\x39\x5f\x04\x00\x8f\xea\x84\x52\xae\x86\xc3\x8c\x30\xbc\x9c\x9e\x9f\xa0\xaf\x1e\x30\x14\x0f\xb0\x21\x00\xe7\x19\xe5\xa7\xa6\xa5\x5f\xe8\x8c\x30\x0a\x2f\xcd\x51\x56\x1f\xac\xc3\x05\xe3\xb7\xc0\xbd\xc1\x9b\xbd\xa3\x3b\x22\xfb\x3b\xd8\x75\x29\x90\x67\x9b\x81\x14\x56\x45\x5f\x4c\x61\xe2\xb2\xa5\x08\xe9\x2b\x1d\x78\xfc\x34\x88\x5c\x9c\x81\x05\x26\xe1\x35\xe9\x2e\xd4\x52\x7b\x88\x7f\x72\x36\x50\x9c\xee\x02\xaf\x97\xec\x5b\xd1\xdf\x92\xb7\x19\xef\x42\xbe\xd6\xe8\x5b\xc1\x7b\x94\x91\x65\xb1\xd1\xfd\x33\xbd\x47\x17\xac\x20\xa4\x2b\x00\x08\xbe\xaf\x24\xf5\x0f\x29\x94\xea\xaf\x13\x7d\x00\x92\xde\x85\x12\xb0\x03\x00\x00")
+
+func tosca_simple_yaml_1_2Policy_typesBytes() ([]byte, error) {
+	return bindataRead(
+		_tosca_simple_yaml_1_2Policy_types,
+		"tosca_simple_yaml_1_2/policy_types",
+	)
+}
+
+func tosca_simple_yaml_1_2Policy_types() (*asset, error) {
+	bytes, err := tosca_simple_yaml_1_2Policy_typesBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "tosca_simple_yaml_1_2/policy_types", size: 944, mode: os.FileMode(436), modTime: time.Unix(1792279540, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _tosca_simple_yaml_1_2Relationship_types = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xbd\x54\x4d\x6f\xdb\x30\x0c\xbd\xe7\x57\xf0\x0f\xcc\x40\x7b\xf4\x2d\xcd\x06\xf4\x30\xac\x40\x97\x5b\x51\x08\x8c\x44\xc7\x44\x65\x49\xa5\x98\x0c\xfd\xf7\x93\xed\x24\xce\xb6\x26\xfd\x00\xb2\x9b\x6d\x3e\xf2\x3d\x3e\x9a\xd4\x98\x2d\x1a\x47\x0d\x07\x56\x8e\x21\x9b\x2d\x49\x2e\x0f\x35\xe8\x10\xca\xdc\x25\x4f\xe6\x05\x3b\x6f\xae\xcc\xf5\x6c\x26\xe4\x71\x40\xb6\x9c\x8c\xbe\x24\xca\xf5\x0c\x46\x70\x75\x1c\xcb\xd5\x5c\x15\x6d\x4b\x79\x19\x7b\x04\x80\x23\xe1\x2d\x39\xd3\x48\xec\xea\x57\x33\xee\x63\xd4\x01\xba\x45\xcf\xce\x28\xca\x9a\x74\x47\x02\x0f\xbb\x14\x8b\x09\x57\xec\x8b\x5c\xda\x73\x74\x14\x14\x1e\x87\xcc\x24\x31\x91\xf4\xb1\x91\x14\xc0\x47\x3b\x50\xec\xdf\x8b\xd8\x52\xb0\x86\xac\xc2\x61\x7d\xf8\x68\x8b\x08\x15\xe4\xa0\x79\x42\x02\x7c\x81\x8e\x83\xf1\x14\xd6\xda\xd6\x70\xb5\x8b\x38\xda\xb2\xa5\x37\x2a\x0a\x3d\x6f\x58\xc8\xd5\xd0\xa0\xcf\x34\x3b\x61\xd3\x22\x86\x40\x56\x2f\x6a\xd3\xb7\xe0\x52\xe4\x33\x26\xd9\xa2\xb3\x98\xc8\xe8\xff\x6e\x6a\xac\xe6\x50\x71\x20\xa8\x16\x07\xe4\x47\x1b\xfd\x4a\x89\x82\xcb\x77\xe1\x72\x7d\xfe\x88\x8e\x4a\x8f\x27\x04\xdc\xc6\xac\xe4\x2e\xc9\x5f\x66\xa9\xe5\x17\x22\x39\x2d\x22\x90\xfe\x8a\xf2\x54\xdd\x70\xf1\xe2\xfd\x33\x3f\x98\xf7\x31\x41\xc7\x6c\xb8\xf2\xf4\xb6\xae\xef\x1c\x9e\xfe\x9f\xae\x9e\xed\xbc\xae\x7e\x08\x7b\x31\xd9\x0a\xa7\x61\x97\x61\xd9\x12\x2c\xef\x7e\x2e\xe6\x20\x05\x00\xf7\x47\x39\xb0\x2c\xc4\x80\xde\x43\xd4\xb6\x8c\x62\x84\xad\x30\xd3\xbf\xb0\xbc\x6b\x11\xfa\x0e\x07\x16\xd4\xb2\xc5\xab\x8d\x4e\xbb\x31\x1e\x42\x76\x67\xd7\x7d\x04\x05\xec\xce\x5d\x85\xb2\x81\x24\x0d\xda\xa9\x76\xf9\x61\x1a\x5e\x6f\x84\x5e\x5f\xbb\x29\xe1\x0f\x57\xaa\x43\xda\x69\xd7\xfa\x0e\xde\x3f\xc6\xe9\x08\x7d\xfa\xb0\xfc\x06\x33\xc8\xf1\x29\x4b\x06\x00\x00")
+
+func tosca_simple_yaml_1_2Relationship_typesBytes() ([]byte, error) {
+	return bindataRead(
+		_tosca_simple_yaml_1_2Relationship_types,
+		"tosca_simple_yaml_1_2/relationship_types",
+	)
+}
+
+func tosca_simple_yaml_1_2Relationship_types() (*asset, error) {
+	bytes, err := tosca_simple_yaml_1_2Relationship_typesBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "tosca_simple_yaml_1_2/relationship_types", size: 1611, mode: os.FileMode(436), modTime: time.Unix(1792279540, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _tosca_simple_yaml_1_3Artifact_types = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xad\x93\x4f\x6b\xc3\x30\x0c\xc5\xef\xf9\x14\x3a\x6e\x87\x65\x8c\xdd\x72\xeb\x36\x06\x3d\x94\x8d\xb5\xeb\x65\x0c\xe3\x25\x4a\x23\x70\x6c\x63\xab\xa5\xf9\xf6\x73\x9c\xfe\xa1\xc3\xa3\x29\x34\x27\x83\x9e\x7e\x7a\x4f\x28\x6c\x7c\x29\x45\x85\x35\x69\x62\x32\xda\x8b\x0d\x3a\x1f\x1e\x05\x70\x2c\x79\x6a\xad\x42\xd1\xc9\x56\x89\x07\xf1\x98\x65\xd2\x31\xd5\xb2\x64\xc1\x9d\x45\x5f\x64\x10\xbe\x28\xcd\xf7\x15\x9f\x7f\x18\xc3\x43\x05\xa0\x42\x5f\x3a\xb2\x1c\x99\x8b\x06\x61\xf1\x36\x7f\x9e\xc0\x64\x27\x86\x45\xc0\x80\x54\x0a\x0c\x37\xe8\x52\x55\x1f\x18\x8e\x36\x08\xb5\x33\x6d\x96\x1c\xf8\x4a\x0a\x8f\x03\x7b\x71\x25\x7a\x75\x91\xb4\x96\x66\xbc\xa0\x55\xa6\x6b\x51\xf3\x05\xa4\x54\xc6\x98\xe0\x47\x7a\x84\x7e\x45\x50\x1b\x17\x04\x7b\x36\x1c\x00\xe7\x5c\xe4\xd3\x56\xae\x46\xa6\x3a\x76\x8d\xa3\xe6\xcb\xd9\xa5\xe0\xa1\x31\x15\x78\x49\x8e\xd7\x52\xc1\x4c\x96\x0d\x69\x84\x9b\xe5\xec\x16\x06\x75\xd2\xcc\xb4\xbf\xa8\x1e\x29\x63\xff\xd5\x97\x4d\x27\xfc\x73\x0b\x3f\x75\x93\x3f\x49\xdf\x8c\xb3\x74\xda\x98\x32\x37\x8f\xcf\x83\x81\x68\x2e\x1c\x39\x7c\x6a\xda\x42\x3f\x08\x7c\x83\x4a\xed\x5a\x5b\x6a\x31\xfe\x54\x05\x48\x6b\x15\x95\x91\x7b\xbf\xbd\xf3\xcd\x4e\x51\x87\x2b\x17\xb8\xe5\x02\xbe\x42\x27\x7c\x8f\x0a\xf4\xde\x71\x33\x76\xcb\xe7\x23\x4d\xfe\x66\x21\xcd\xe8\xac\x43\xc6\x0a\x86\x51\xa0\xa4\x5e\xad\x8f\xb7\xf2\x6f\x2e\x1b\xe5\x89\x6c\xb6\x0b\xd9\x7e\x01\x22\xb8\x56\xad\x9a\x04\x00\x00")
+
+func tosca_simple_yaml_1_3Artifact_typesBytes() ([]byte, error) {
+	return bindataRead(
+		_tosca_simple_yaml_1_3Artifact_types,
+		"tosca_simple_yaml_1_3/artifact_types",
+	)
+}
+
+func tosca_simple_yaml_1_3Artifact_types() (*asset, error) {
+	bytes, err := tosca_simple_yaml_1_3Artifact_typesBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "tosca_simple_yaml_1_3/artifact_types", size: 1178, mode: os.FileMode(436), modTime: time.Unix(1792279540, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _tosca_simple_yaml_1_3Capability_types = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xc5\x57\x4d\x6f\xdb\x38\x10\xbd\xe7\x57\x0c\xd0\x6b\x6b\x34\xd8\x9b\x0f\x05\x12\x27\xe8\x06\x48\x93\xa0\xce\xee\x65\xb1\x10\xc6\xd4\xd8\x26\x42\x91\x5a\x72\x94\xd6\xfd\xf5\x3b\xa2\x28\x45\xf1\x47\x2c\x3b\x06\x7a\x11\x04\x72\xf8\x38\x1f\xef\x8d\x46\xec\x82\xc2\x2c\xa7\xb9\xb6\x9a\xb5\xb3\x21\x7b\x26\x1f\xe4\x65\x0c\x1c\xb7\x82\x2e\x4a\x43\xd9\x0a\x0b\x93\x9d\x67\x7f\x9c\x9d\x29\x2c\x71\xa6\x8d\xe6\x55\xc6\xab\x92\xc2\xf8\x0c\x1a\xd3\x51\xb7\xa3\x29\x8c\x2e\x98\x51\x2d\x0b\xb2\x5c\x1b\x00\xe4\xe4\xf5\x33\xe5\xd9\xdc\xbb\x62\xbc\xed\xc0\x77\xe7\xf8\x6c\x3b\xd6\xc4\x15\x65\xc5\x34\x10\x68\xe2\x2c\xa3\xb6\xe4\xa3\x79\xe9\x5d\x49\xbe\xde\x68\x8e\x03\x58\x2c\xa8\x7d\x97\xeb\x24\x86\x31\x04\xf6\xda\x2e\xba\x45\x4f\xff\x55\xda\x53\x3e\x86\x39\x9a\x40\x3b\xdd\x4a\x17\x1d\x12\xe1\x0e\x9f\xaa\x22\x53\x65\x15\xd6\xfd\xd2\x96\x69\x91\x22\xd9\xe6\x58\xbb\xae\xa4\x72\xec\xc5\x19\xee\x41\x00\x7c\x82\x85\x27\x64\xf2\x99\xf3\x99\x1c\x45\x33\x86\xf3\xb4\x2f\xd7\x89\xab\xb2\x48\x56\xad\x36\xf2\xa1\xd0\xa0\xff\x54\x09\x29\x46\x9d\xd1\x29\xbd\xf8\x3c\x3a\x87\xaf\x7f\xfe\x4a\x56\xb9\x0e\x4f\xc2\xb4\x5f\xf4\x96\x1f\xf5\xfe\x49\x5d\x80\x6f\x97\xc9\xa4\xa0\xe2\xf7\x5c\xbf\x9d\x58\xaa\xe1\xfb\xc1\x04\x4b\x3a\xd9\x81\x7a\x6d\xf3\xd2\xe9\xc3\xf4\xb8\x9d\xad\xb2\xc2\x4e\x39\x33\x58\x45\xec\xab\x97\x1c\x49\xb3\xc1\xca\xb0\xac\xaa\xb2\x05\x74\x9e\xd7\xc1\x1a\x97\x72\x64\x8c\x6d\x66\x64\x89\x7f\x38\xff\x34\x7a\x10\xdb\x2b\x9a\xef\x29\x45\x20\x55\xf9\x8d\x7a\xce\x9c\x33\x84\x76\x6f\x19\x3b\x17\xfb\xcb\x95\x37\x59\x89\xbc\x3c\xb0\x77\xbc\x44\x98\x1d\xd1\x79\x52\x7b\x68\x62\x3f\x1e\xa0\x17\xd2\xc3\xf7\x9b\xbf\x2f\x1e\xaf\xd3\x46\x6c\xfb\xc8\xce\xbf\x17\x36\xb8\xca\xab\x01\x42\x78\x46\xa3\xf3\x4c\x9e\x95\xf0\x09\xfe\x49\xe7\x3e\x02\xa3\x5f\x10\x7f\x84\x92\xc8\xc3\xbf\xbd\xac\x6d\xf4\xc4\x02\xcb\xe3\x75\x58\x68\x9b\x19\xb2\x0b\x29\x63\xd7\x09\x01\xe4\x2b\xe5\x57\x59\x50\x4b\x2a\xb0\x6f\xbf\x9f\x89\xd3\x92\x54\x3c\x80\x2c\x09\x9b\x89\xfa\xba\x0b\x75\x99\x61\x9e\x7b\x0a\x61\x47\x6e\xdf\x96\xe9\xe8\x22\x17\x67\x07\x8a\xb5\x3d\x14\xad\x3f\xc0\x64\x89\x76\x41\xd0\xae\x26\x35\x48\xb1\x73\xad\xea\x62\x0b\x44\xd4\x24\xd4\x80\xa0\x39\xb4\x75\x04\x37\xef\x65\x72\x53\xf9\xc3\x64\xf5\xa2\xf0\xbe\xee\x77\xd6\x24\x35\xc4\x68\xbc\x27\x27\x57\x52\x84\x19\x06\x3a\x34\x2d\x7b\x60\x1f\xaa\x99\xd1\xea\x98\x5c\x6f\xa6\xa8\xcb\x3e\x2f\xa9\x4b\x6b\x5f\xc0\x75\xf2\xab\xd0\xec\xcf\xb5\x0f\x0c\x65\xbc\xbe\x35\x82\xb9\xab\x6c\x7e\xb8\xf2\x5f\x14\xfe\xd7\xe5\xed\xcd\x64\x70\xde\x5f\x99\xcf\x8d\x43\x16\xd4\x71\x0f\x36\x28\xaf\x4b\x8e\x33\xe1\x97\x1e\x40\x62\x13\x05\x89\x04\x39\x86\x93\x02\x49\xb4\x87\xb0\x74\x95\xc9\x61\x46\x80\xc6\xb8\xda\x36\x6f\x18\x87\xa2\x6c\x67\x22\xd9\xd2\x7d\x70\xf3\x90\x70\x50\x88\x8a\x21\x38\xa5\xa3\xfd\x0f\xcd\xcb\x88\xdd\xaa\x6e\x28\xf7\x5e\x37\x84\x20\xe2\x95\xf1\x0a\xe8\xa7\x94\x4b\xd7\x63\x29\x9a\x76\xf8\x90\xa1\xf7\x75\x7a\x5f\x85\xfc\x28\x77\xbb\xf8\x8e\x06\xda\xfa\x79\x5a\xe8\x20\xdf\xf3\xc6\xbd\xab\xbb\xe9\x71\xdd\x73\xab\x57\xdb\xb9\x7a\xd7\x84\x7f\x82\x51\xf3\x54\xe3\x6f\x5b\x90\x4b\x21\x02\xce\xcc\x50\x4d\xde\xb9\x7c\x1f\xe2\xad\xb6\x4f\xa7\x41\xac\xb7\xde\xff\xff\x71\x2f\x29\x8c\x2c\x9d\xae\xa4\xe8\xc5\xfb\x6b\x80\x5e\x2d\x35\x93\xe2\x2d\xcd\x74\x10\x75\xa2\xe9\x31\x07\x65\xd2\x6e\xbe\x53\x35\xb7\x8f\x01\x68\x7f\x0f\xd7\xce\xa6\xe5\x03\x29\x54\xa7\xa8\xcd\xe6\x9a\xe4\x1e\xef\xa7\x93\x0b\xf0\x62\x00\x93\xee\x8f\x13\x1e\xe5\xb2\xba\x99\x80\x93\x96\xe0\x93\x51\xfd\x41\x58\x37\x0a\xa9\x3e\xb1\xdf\xec\xb8\x7d\x5a\x4f\xf7\xc3\x69\xf6\x46\x3d\xeb\xa9\x42\x4b\x97\x45\xab\x68\xef\x3f\x5c\xd7\xa1\xda\xf9\xa3\xc0\x9f\xef\x38\x9d\x16\x86\x23\x0c\x2b\xcd\x54\xc6\x04\x5c\xd0\x6f\xe9\x37\xff\x03\x49\x76\xd0\xa3\x95\x10\x00\x00")
+
+func tosca_simple_yaml_1_3Capability_typesBytes() ([]byte, error) {
+	return bindataRead(
+		_tosca_simple_yaml_1_3Capability_types,
+		"tosca_simple_yaml_1_3/capability_types",
+	)
+}
+
+func tosca_simple_yaml_1_3Capability_types() (*asset, error) {
+	bytes, err := tosca_simple_yaml_1_3Capability_typesBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "tosca_simple_yaml_1_3/capability_types", size: 4245, mode: os.FileMode(436), modTime: time.Unix(1792279540, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _tosca_simple_yaml_1_3Data_types = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xcd\x55\x4d\x6f\xdb\x30\x0c\xbd\xe7\x57\xf0\x07\x64\x01\x8a\x22\x3b\xe4\x56\xb4\x97\x0e\x5b\x3b\x2c\x39\x0c\x18\x06\x41\xb3\x98\x44\x8d\xf5\x31\x8a\xce\x96\x7f\x3f\xda\x71\x93\x74\x51\x12\xb7\x4b\xd1\xf9\x60\x1b\x24\xf5\x1e\xf9\x28\x51\x1c\x52\xa1\x95\xc1\xa9\xf5\x96\x6d\xf0\x49\x2d\x91\x92\xfc\x8c\x80\x1b\x57\xb2\x2e\x96\xa8\x56\xda\x95\xea\x42\x5d\xf6\x7a\x46\xb3\x56\xbc\x8a\x98\x46\x3d\x90\xa7\x09\x1b\xd4\xd6\xc6\x38\xf8\x12\x02\xaf\x3d\x00\x06\x53\x41\x36\x72\x83\x37\x99\x23\x4c\xee\xc7\xd7\x57\x40\x12\x02\x37\xb2\x02\x26\xb2\x04\x74\x59\x42\xe0\x39\x52\xeb\xfe\xa1\x13\x6e\xdd\x49\x50\xc8\x2e\x11\xa6\x14\x5c\x2f\x4b\xf9\x90\x04\x7f\x43\x59\x07\x1b\x55\x47\x8f\x20\x31\x59\x3f\xcb\x65\x73\xd5\xfa\x60\x1e\x4a\x53\x7f\x35\x7c\x18\xdf\xdf\x81\x09\x45\xe5\xd0\x73\x9e\xe9\xb7\x2b\xff\x9d\xc8\xc3\xd7\x4f\x1f\x4f\x10\x5d\x13\x1a\x71\x5a\x7d\x80\x2f\xa7\x7a\x1b\x18\x29\x44\x24\xb6\x8f\x0d\x6a\x6d\x1c\x8a\x50\x6e\x2d\x42\x29\x0b\xff\x4a\xbc\x7e\x08\x7f\x56\x56\xd8\x47\x30\xd5\x65\xc2\x8d\x87\xc3\x02\x7d\xd3\xf8\x0e\x20\xb2\xa1\x74\x55\xf2\x08\xa2\x4e\xe9\x57\x20\xf3\x14\xe6\x24\xc2\x02\x57\x69\x3f\xc8\xe9\xd8\x21\x51\x00\x11\x8e\x56\x2a\x15\x73\x74\x7a\x17\xe5\x00\x59\x95\x90\x5e\x20\x4c\xb6\x6f\x13\xeb\xf0\xd6\x33\xd2\xf2\x4c\x9d\x4b\xac\x89\x15\x0b\xec\x7e\x8a\xb5\x55\xfc\x2e\xaf\x0a\x53\xb5\x15\x05\xbd\x79\x39\x48\xb6\x52\x8f\x2c\x8d\x5d\x0c\xee\xd6\xdf\x5b\x3f\x0d\x67\x29\xb8\xc5\x55\x5e\xbb\xd3\x3b\xed\x31\xd8\x9a\x93\xa1\xda\x18\xc2\x94\x30\xb3\xaf\x4a\x9b\xf8\xf9\xdb\xe7\xa8\x2a\x9f\x03\xf1\xd9\x24\x89\x02\xd6\x4d\x8f\x26\xb2\x83\x18\xcf\xd0\xcd\xe9\x42\xb5\xda\xfd\x87\x1a\xdf\xe0\x34\x2f\xb1\x95\x43\x38\x43\x6a\x7d\x85\xdc\x6c\x4c\x5a\x8c\x3b\xa9\xbd\x93\x20\x45\xda\xcf\x84\xef\x1b\x5c\xf4\xe1\xfd\x70\x78\x39\x84\xef\xa7\x59\xc7\x11\x8b\xb7\x9b\xcb\x4f\x0e\xf6\xce\xac\xe5\x62\xf7\x0c\x67\x4b\x5e\x97\x2d\xb3\xc9\x1a\x25\xef\x4a\x72\x91\xd2\x2b\x13\xfb\xf5\xea\x3e\xd8\x99\x8b\x22\xc0\x26\x15\x4d\x33\xe4\xcc\xcc\x38\xde\x91\x2e\xb7\x49\x83\xdc\xaa\xbf\x87\xdf\x98\x3b\x8d\xfa\x23\x55\x1e\x68\xee\x66\xb0\x86\x8a\x0a\x7c\x8d\xda\xd6\xc8\x6f\x51\xdb\x1f\xb0\xae\xbe\xf6\xce\x09\x00\x00")
+
+func tosca_simple_yaml_1_3Data_typesBytes() ([]byte, error) {
+	return bindataRead(
+		_tosca_simple_yaml_1_3Data_types,
+		"tosca_simple_yaml_1_3/data_types",
+	)
+}
+
+func tosca_simple_yaml_1_3Data_types() (*asset, error) {
+	bytes, err := tosca_simple_yaml_1_3Data_typesBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "tosca_simple_yaml_1_3/data_types", size: 2510, mode: os.FileMode(436), modTime: time.Unix(1792279540, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _tosca_simple_yaml_1_3Group_types = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x65\x4e\x3b\x0a\xc3\x30\x0c\xdd\x73\x0a\x9d\xc0\x50\xba\x65\x2b\x1d\x3a\x16\x9a\xec\xc6\xd8\x72\x23\x70\x6c\x23\xab\x81\xdc\xbe\x8e\x9b\x90\xa1\x6f\x12\xef\xa7\x27\xa9\x58\xa3\x1d\x7a\x8a\x24\x94\x62\xd1\x0b\x72\xa9\x47\x0f\xd2\xa4\x42\x73\x0e\xa8\x57\x33\x07\x7d\xd1\xd7\xae\x7b\x73\xfa\x64\x2d\x6b\xc6\xd2\x77\x50\xd1\x7c\xaa\xd1\x45\xbd\x52\x92\x1f\x0d\xe0\xb0\x58\xa6\x2c\xad\x6d\x9c\x10\xc6\xe7\x70\xbf\xc1\x63\x73\xc2\x58\x0b\xc0\x84\x00\x49\x26\xe4\x3f\xa9\xd4\x34\xd3\x82\xe0\x39\xcd\x7b\x1f\x45\x41\xf6\xc6\x1e\x8f\x37\x0c\x62\xa2\x33\xec\x4e\xa6\x0e\xaa\xf9\x7d\xbe\x3a\x33\x2a\x26\x87\x2a\x90\x47\xbb\xda\x80\xea\x48\x76\x5f\x1c\xc0\xcd\x26\x03\x01\x00\x00")
+
+func tosca_simple_yaml_1_3Group_typesBytes() ([]byte, error) {
+	return bindataRead(
+		_tosca_simple_yaml_1_3Group_types,
+		"tosca_simple_yaml_1_3/group_types",
+	)
+}
+
+func tosca_simple_yaml_1_3Group_types() (*asset, error) {
+	bytes, err := tosca_simple_yaml_1_3Group_typesBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "tosca_simple_yaml_1_3/group_types", size: 259, mode: os.FileMode(436), modTime: time.Unix(1792279540, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _tosca_simple_yaml_1_3Interface_types = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x9d\x93\xcb\x8e\xab\x30\x0c\x86\xf7\x3c\x85\x5f\x60\x22\x1d\xcd\x6e\x76\xa3\x59\x9d\xd5\x48\x67\xba\x8f\xd2\xc4\x14\x4b\x21\x46\x89\x4b\xc5\xdb\x9f\x10\xa0\x43\x6f\x52\xe9\x0e\xb0\xfd\x7f\xbf\x2f\x08\x27\x6b\xb4\xc3\x9a\x02\x09\x71\x48\xba\xc7\x98\xf2\xc3\x07\x48\x09\x25\x6a\x3b\x8f\x7a\x30\xad\xd7\x7f\xf4\x7b\x55\x51\x10\x8c\xb5\xb1\xa8\x65\xe8\x30\x7d\x54\x30\x65\xaa\x73\x20\xa9\x7f\xcc\x32\x06\x00\x1c\x46\xea\xd1\xe9\x3a\x72\x3b\x4b\x2a\x0c\x42\x32\x94\xa4\x39\x27\xd9\x48\x9d\x14\xea\xae\x41\xd8\x7d\xff\x7c\x7d\x42\xcc\x71\xf8\xbb\x88\xc2\x2e\xd3\xc0\x78\x0f\x2c\x0d\xc6\x39\x67\x6f\x12\x5e\xe5\xa4\x99\x09\x23\xb2\xba\xe7\x2e\xb0\x43\xe5\xa9\x46\x3b\x58\x8f\xea\x47\x4c\x70\x26\xba\xc7\x86\xaf\x3a\x2b\x79\xdc\x61\x34\x65\x62\x53\x1d\x80\x8d\x68\x04\x97\xb7\xab\xb6\x16\x08\x9c\xb9\x73\xfe\xaf\x90\x5a\x74\x38\xd4\x74\x38\xc6\x2d\x52\x4b\xc9\xad\x5a\x12\x13\xe5\x79\xa5\x92\x7e\x4f\x85\xbb\x2d\x22\xdc\xdd\x6a\x38\xf4\xb8\x65\x3e\x53\xfe\x5a\xe7\xde\x32\x23\xfa\x69\x0d\x0d\x75\xea\xeb\x72\x74\xaf\xef\xb2\x8b\xa8\xcf\x43\xd5\x89\x8f\xd1\x3e\x72\xfe\xbd\x14\x67\xc0\x58\xf7\xf6\xbb\x8c\x7c\xa8\x30\xd5\x02\x06\xd7\x71\x66\xab\xbb\x80\x3c\xf4\x03\xca\x6b\x80\xa9\xf6\x16\xc0\x49\x5e\x6b\x21\x17\x3e\xd9\xc3\x25\x62\x43\x13\xb7\x88\x07\x5d\x18\xe7\x36\xe8\x06\x16\xaa\x87\xb5\xe5\xf1\x57\x07\xae\xc1\x2c\x80\xf2\x61\x8f\x14\x0e\xa3\x36\x3a\xe8\xc9\xe4\xe8\xc5\x15\xad\xd8\xcf\x8f\x6d\xc5\x5e\xa3\x0a\x7b\x6d\xe6\xd4\x90\x6d\x80\x52\x7e\x3b\x81\xe9\x0d\x79\xb3\xcf\xc7\xfe\xd8\xc6\xa4\xa6\x6d\x63\xc2\x01\xdd\x06\x2b\x33\x35\x71\x8b\xf9\x6c\xc6\x1b\x97\x01\x38\x82\x11\x89\xb4\x3f\x4a\x31\xb7\xf2\x3b\x13\x66\x40\xc4\x96\xfb\x2d\x4b\x9d\x0a\x2e\x27\xad\xaa\xff\x6f\xe5\x20\xd9\x60\x06\x00\x00")
+
+func tosca_simple_yaml_1_3Interface_typesBytes() ([]byte, error) {
+	return bindataRead(
+		_tosca_simple_yaml_1_3Interface_types,
+		"tosca_simple_yaml_1_3/interface_types",
+	)
+}
+
+func tosca_simple_yaml_1_3Interface_types() (*asset, error) {
+	bytes, err := tosca_simple_yaml_1_3Interface_typesBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "tosca_simple_yaml_1_3/interface_types", size: 1632, mode: os.FileMode(436), modTime: time.Unix(1792279540, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _tosca_simple_yaml_1_3Node_types = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xcd\x59\xcd\x6e\xe3\x36\x10\xbe\xfb\x29\x08\xec\xa5\x05\x62\x63\x17\x0d\x7a\xf0\x2d\x8e\xb7\xed\x02\x9b\x38\x88\xb3\xe8\xa1\x08\x04\x9a\xa2\x6d\x36\x14\xa9\x92\x94\x13\xf5\xd4\xd7\xd8\xd7\xeb\x93\x74\x48\x89\xb2\xac\x1f\x5b\x92\x93\xa0\x7b\xd8\xf5\x4a\xf3\xcb\x99\x6f\x66\x38\x32\x52\x13\x1c\x84\x74\xcd\x04\x33\x4c\x0a\x1d\xec\xa8\xd2\xf0\x63\x8a\x8c\x7b\xa5\x59\x14\x73\x1a\xa4\x38\xe2\xc1\xa7\xe0\xa7\xd1\x48\xc8\x90\x06\x26\x8d\xa9\x9e\x8e\x50\x46\x34\xb1\xcf\xf4\xe4\x6a\xa5\x8d\xc2\xc4\x4c\xae\x65\x14\x27\x86\xda\xf7\x08\x85\x54\xb1\x1d\x0d\x83\xb5\x92\xd1\xf4\x80\xfe\x5e\x4a\xe3\x48\x08\x8e\xf1\x8a\x71\x30\x20\x13\x6a\xff\x6c\xa5\x36\xfe\x37\xa8\x01\x7d\x9e\xb9\x4c\xed\x55\x15\x84\x3b\xcc\x59\x18\x68\x99\x28\xe2\xad\x44\x7f\x3c\x8e\xda\x2c\x5d\x1a\xa9\xf0\xa6\xbb\xa5\xb1\x92\x31\x55\x65\x3b\x05\x8e\x68\xd5\x4e\x10\xce\xc4\x26\x7f\xa8\xd9\xdf\x75\x02\x82\x39\x56\xe3\x04\xce\x7c\x62\xdf\x17\xaf\x21\x10\x38\xe1\x66\x8a\x3e\xa2\x9b\x59\xf1\x94\x40\x5c\xc0\x5e\x26\x8c\xde\x4b\x42\x68\x8c\x36\x8a\x62\x43\x55\x20\x55\x40\xff\x4a\x30\xcf\xf9\x2a\xde\xe6\x4e\x4e\x66\x5c\x92\xa7\x8e\x1e\x57\x4f\xa8\xc5\xfb\x5e\xce\xf5\x70\xe3\xd3\xde\xfd\x9d\xe4\x49\x44\x03\x16\x1e\x3d\x65\x84\x14\xb0\x32\x45\xc3\x29\x5a\x63\xae\xbd\x56\x2d\x70\xac\xb7\xd2\x0c\xe3\x6f\x4a\x4c\x6c\x0c\x26\xdb\x88\x8a\x2e\xe9\x79\x55\x10\x57\x83\xd2\x11\x23\x55\x4c\x8d\x72\x13\x14\x5b\xc1\xff\x0a\xa3\x62\xe0\x87\x23\x0c\x70\x18\x2a\xaa\xf5\x51\x57\xe3\x64\xc5\x19\xe9\x44\x2a\xa8\x79\x96\xea\xa9\x46\x14\xe1\xb8\x78\x02\xbe\xa9\x34\xd0\x64\x4b\x23\x5c\x0e\x6b\xf9\x4c\x42\x6c\xb0\x03\xe3\x24\x97\x38\xb9\xcd\xfe\xfd\x22\xd6\xd2\x9b\x25\x95\x79\x03\x45\x77\x20\xb6\xd0\x92\xc7\xd8\xc6\xa3\x50\x35\x46\x00\x0b\xcc\x03\x5d\x06\x46\x9e\xb0\x3e\x94\xe9\xa9\xe0\xee\x79\x6c\xd8\xa6\x27\xe1\x57\x62\x50\x94\x63\x57\x79\xb7\x2c\xf6\x8c\xe5\x67\x5e\x0f\xd5\x0f\xb2\xc4\x26\x09\x49\x94\xa2\x82\xb8\x0a\xf7\xf1\x02\x7d\xbb\x9d\x2d\xbe\xdd\xce\x3f\xcf\x1f\xdf\xa5\xa6\x1e\x78\x28\xd7\xe6\x19\x2b\x6a\x19\xa5\x80\xf3\x78\x1c\xf9\x90\x85\xb1\x64\x9d\x90\xf2\x39\x27\x9d\x5c\x85\x11\x13\x39\xbd\xd4\x1d\x38\x17\x50\x93\xe0\xb4\xc4\x66\x99\x6a\x43\x23\x0f\x7c\x5b\x85\x56\x9c\x76\x10\xb0\xcc\x49\x73\xca\x15\x13\x21\x48\xeb\xc0\xe8\x73\x6c\x06\x1c\x4e\x40\x0d\xe3\xc2\x40\xb9\xa3\x6a\x72\x15\xc7\x80\x39\x17\xd3\xce\xbd\xa6\x39\x59\x0f\x63\x78\x32\x47\x0b\x13\x8e\xa6\xe8\xde\xd0\xfb\x44\x18\x16\xf5\xcb\xcf\xdf\xc0\x24\x1a\x2e\x44\x61\x63\x7f\x28\x1d\xc2\x62\xec\x0b\x4f\x0f\x09\x3e\x7f\xda\x63\x90\xbb\x76\xea\xfc\x6b\xc9\x7c\x3e\x9c\x0e\x43\x30\x24\x33\x2b\x4e\xcd\xa1\xcc\xad\xb0\x7e\xd3\xb9\xc5\xca\xd5\x44\xb1\xd8\x64\xd3\xe0\x96\x42\xa1\xdc\x40\x12\x73\xc7\x8b\xe4\xda\x3d\x0b\x73\x53\x4a\x55\xbc\x2a\x16\xa2\x42\x37\xa5\x0c\xac\xc9\xb5\x4c\xee\x47\x22\xc0\x17\x9e\x82\x11\x85\x5c\xa4\xa9\xda\x31\x42\xd1\x33\xe3\x1c\x71\x06\xa9\x26\xc0\x47\xb4\x96\xca\xd1\x9c\xe8\xff\x09\xb0\xf7\x75\x53\xba\xdf\xe0\xa7\x65\x46\x98\x10\x09\x99\x93\x39\x6d\xb5\xce\x67\x08\xdb\x1a\xc5\x6c\x67\xb6\x94\x27\x4c\x88\xb1\xd6\x90\xcc\xe1\x60\x33\xbc\x00\xa7\xdd\xbe\x01\x0b\xca\xa6\x1d\xd5\xff\x8e\x55\x64\x3e\xbb\x59\x0e\x2f\x1c\x4d\x08\xf3\x49\x10\x0c\x69\x24\x1e\x24\x35\xf0\x80\x99\xc3\x8a\x40\x1d\x45\x0a\x00\x16\x74\x0c\x70\x73\x76\x1c\x0b\xbc\x95\xde\x14\xfd\x9b\xa5\x47\x45\x0f\xd4\x75\x56\x5f\xa0\xb1\xac\xa8\x0d\x7e\x08\x8b\xd0\x89\xa6\xda\xe8\xd7\xae\x94\x2d\xa3\x47\x63\x29\x44\xb5\x4b\xde\x57\x89\xc3\x19\xd4\x4f\x18\x91\xd4\x19\x85\x12\xf3\x8d\x54\xcc\x6c\xa3\x81\xe1\xd5\x30\x91\x26\x60\x36\x7d\x01\xc1\xcc\xe2\x10\xf3\xd6\x83\x22\x9c\xd1\x7e\x59\x7e\xe7\xa6\xf9\x51\xd7\xb1\xb0\x31\xe2\xbf\x70\xe9\x06\x28\xf4\xc3\x97\xbb\x1f\x73\x1b\xfe\xfd\xe7\xbb\x46\x52\x64\x19\xe1\x74\xf8\xa6\x0c\x86\x0b\x7b\x97\x13\x94\x40\x9e\x1c\x1b\xac\x71\x75\xea\xe9\xd7\xc9\xfb\x94\x92\x7b\x69\xaf\x43\xbd\x26\xe4\x86\xc3\xb8\xce\xbc\x62\xd2\xe5\x38\x00\x1f\x41\x9a\x47\x52\xd9\xd6\x87\x43\xb4\xca\xb2\x29\x2c\x3b\xa6\xab\x69\x57\xb9\xe1\x9c\x91\x79\x2c\x2e\x96\x31\x83\xb1\x9d\xaf\x13\x2e\x4f\x5f\xc2\x33\xa8\xc1\xdf\x49\x06\xb2\xcb\x0b\xf4\x33\xf2\x27\x45\x58\xa8\x86\xdd\xbf\x0d\x56\x70\xf9\x8e\x07\x31\x43\xd5\x1f\xca\xba\x81\xdb\xf0\x33\x4e\x87\xb2\xe7\x41\x0c\x3a\x4c\x49\xc7\x05\x0c\x5d\x5b\xd0\x8d\xab\x14\x36\xc3\x86\xca\xf0\x26\x38\x96\x21\x02\xe2\x6d\xaa\xed\xbc\x17\xd4\xa6\xf1\xb3\x16\x28\x9c\x89\xa7\x1e\x97\xab\xaf\x40\xde\x34\x03\x97\x2f\xf8\xe7\x81\xac\xcb\x26\xa4\xed\x8c\xa0\x31\xd7\x07\xcc\x76\x78\x1a\x95\x34\x2d\xfb\x06\x6d\xfa\xbc\x03\x3a\xf0\x82\x2a\x66\xac\xa4\xe4\x14\x8b\xee\x55\xa2\xfc\x18\xce\x45\x61\xb1\xa1\x81\x43\xf0\xa0\xb3\x29\x44\x00\x8e\x07\x08\x68\xe8\x29\xe3\x4a\xf2\x9c\xe8\x24\xb5\x14\xea\xde\x4f\xca\xac\x45\x5b\x19\xd7\x77\x03\x1d\x2d\x28\x36\x04\xfd\x2d\xb0\xac\xd6\x82\x96\xa5\xee\x62\xf5\x27\x74\xac\xd7\xdd\xea\xbe\xee\x4e\xbb\xd7\xf6\xfa\x57\xbf\xf6\x8d\xf0\xcb\x9b\x6b\x69\xaa\x4e\xf9\xee\x62\xc8\x8d\xa3\x1a\x22\x5b\x75\xda\x23\x02\x69\x0d\x59\xb3\x2f\x4d\x07\x83\xc8\x03\x4c\x5d\x0f\x8b\xe5\xf5\x15\xba\x05\x51\xe8\x01\x54\xc3\x20\xca\x91\x84\x71\x4c\xe5\x6f\xdc\xd4\x5b\xbc\xd6\xb9\x12\x64\x75\xb4\x6c\x89\xb3\x2f\x3a\x27\xda\x49\x46\x74\x3a\x05\xa0\x39\x1d\xa3\x68\x3a\xdb\x35\x44\x22\x51\x5d\x16\x1f\xd6\xad\x23\x83\x65\x48\x63\x88\x0f\x8c\x76\x69\x8f\xb9\xb2\x90\xd9\x76\x7f\x2d\x42\xd1\x15\xa2\x73\x67\x86\x5e\x88\xd6\xa9\x13\x95\xc7\xce\x7c\x9a\xb2\xdd\x41\xad\x31\xd9\x9f\xcb\xd2\xc0\x2d\x0a\xd7\x2f\x90\x99\xce\x3d\xbd\xb3\x73\xc2\xd9\x9a\x92\x94\x70\x3a\xf1\x7c\xb5\xda\x50\xbd\xbd\x9e\xd1\x1f\x3f\xa0\x50\x46\x00\xab\xb1\x8e\x29\x61\x6b\xb8\x06\xe8\x5c\x3a\x40\x2e\x17\x8f\xf2\x31\xd5\x0f\x8b\xfe\x79\xdb\xf8\x7a\x48\xde\xd6\x3e\xdc\x92\x25\x20\xf0\xdc\x42\x05\x80\x3b\x3a\xbe\xf1\xbf\x2e\x28\xff\x2f\x0b\x91\xea\x22\xbd\xd7\x4e\xa4\x12\xd3\xdf\xe9\x6a\xc8\x12\xb9\x1e\x50\x28\x94\x86\xbe\x98\x40\x15\xd5\xe9\xfc\x4f\x62\x71\x3c\xa8\x5e\xbe\x73\x38\xe0\x08\x97\x54\xed\x0e\xa8\xce\x0a\x48\x26\xed\xf5\x16\xca\x1f\xd0\x5d\xf6\x1d\xef\x02\x71\x9c\x42\xa1\xbf\x2c\xbe\xa0\xe8\xd2\x46\x6c\x7f\xd6\x1d\xae\xcf\x19\x8a\x3a\x71\x1c\x7c\x7a\x79\xc3\x95\xcd\x61\x2a\x43\x55\xfc\x0f\xa1\x50\x96\x09\x85\x20\x00\x00")
+
+func tosca_simple_yaml_1_3Node_typesBytes() ([]byte, error) {
+	return bindataRead(
+		_tosca_simple_yaml_1_3Node_types,
+		"tosca_simple_yaml_1_3/node_types",
+	)
+}
+
+func tosca_simple_yaml_1_3Node_types() (*asset, error) {
+	bytes, err := tosca_simple_yaml_1_3Node_typesBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "tosca_simple_yaml_1_3/node_types", size: 8325, mode: os.FileMode(436), modTime: time.Unix(1792279540, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _tosca_simple_yaml_1_3Policy_types = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xc5\x92\x41\x6a\xc4\x30\x0c\x45\xf7\x39\x85\x4e\x10\x28\xdd\x65\x57\x7a\x80\x86\x4e\xba\x36\xc6\x56\x12\x81\x63\xb9\x92\x33\x90\xdb\xd7\xf1\xb4\x4c\xa1\x81\x96\x59\x74\xbc\x32\xfa\xd6\xff\x4f\xc2\x99\xd5\x59\xe3\x71\xa4\x48\x99\x38\xaa\x39\xa3\x68\xb9\x74\x90\xab\xa4\xb4\xa4\x80\x66\xb3\x4b\x30\x0f\xe6\xb1\x69\x12\x07\x72\x9b\xc9\x5b\x42\xed\x1a\x28\xa7\x3e\x6c\x6b\x9d\x50\xdb\x57\xe6\x7c\x11\x00\x3c\xaa\x13\x4a\xb9\x1a\x0e\x33\xc2\xf0\x72\x7a\x7e\x82\xbe\x7a\xc0\x50\x3c\xc0\x86\x00\x9c\x67\x94\x9f\x9a\x96\x7e\xa1\x33\xc2\x28\xbc\x34\x47\x59\x7d\xb0\x0e\x17\x8c\xdf\x02\xf7\x06\x6f\xf6\x8e\xee\x88\xec\xef\x60\xd7\xa5\x40\x9e\x6d\x06\x52\x58\x15\x7d\x31\x85\x89\xcb\x96\x22\xa4\xaf\x74\xe0\xf1\xd3\x20\x72\x71\x06\x16\x98\x84\xd7\xa4\xbb\x50\x4b\xed\x21\xfe\xc9\xd9\x40\x71\xba\x0b\xbc\x5e\xb2\x6f\x45\x7f\x4b\xde\x66\xbc\x0b\xf9\x5a\xa3\x6f\x05\xef\x51\x46\x96\xc5\x46\xf7\xcf\xf4\x1e\x5d\xb0\x82\x90\xae\x00\x20\xf8\xbe\x92\xd4\x3f\xa4\x50\xaa\xbf\x4e\xf4\x01\xf6\x76\x17\x13\xb0\x03\x00\x00")
+
+func tosca_simple_yaml_1_3Policy_typesBytes() ([]byte, error) {
+	return bindataRead(
+		_tosca_simple_yaml_1_3Policy_types,
+		"tosca_simple_yaml_1_3/policy_types",
+	)
+}
+
+func tosca_simple_yaml_1_3Policy_types() (*asset, error) {
+	bytes, err := tosca_simple_yaml_1_3Policy_typesBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "tosca_simple_yaml_1_3/policy_types", size: 944, mode: os.FileMode(436), modTime: time.Unix(1792279540, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _tosca_simple_yaml_1_3Relationship_types = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xbd\x54\x4d\x6f\xdb\x30\x0c\xbd\xe7\x57\xf0\x0f\xcc\x40\xd1\x9b\x6f\x69\x36\xa0\x87\x61\x05\xba\xdc\x8a\x42\x60\x24\x3a\x26\x2a\x4b\x2a\xc5\x64\xe8\xbf\x9f\x6c\x27\x71\xb6\x35\xe9\x07\x90\xdd\x6c\xf3\x91\xef\xf1\xd1\xa4\xc6\x6c\xd1\x38\x6a\x38\xb0\x72\x0c\xd9\x6c\x49\x72\x79\xa8\x41\x87\x50\xe6\x2e\x79\x32\x2f\xd8\x79\x73\x65\xae\x67\x33\x21\x8f\x03\xb2\xe5\x64\xf4\x25\x51\xae\x67\x30\x82\xab\xe3\x58\xae\xe6\xaa\x68\x5b\xca\xcb\xd8\x23\x00\x1c\x09\x6f\xc9\x99\x46\x62\x57\xbf\x9a\x71\x1f\xa3\x0e\xd0\x2d\x7a\x76\x46\x51\xd6\xa4\x3b\x12\x78\xd8\xa5\x58\x4c\xb8\x62\x5f\xe4\xd2\x9e\xa3\xa3\xa0\xf0\x38\x64\x26\x89\x89\xa4\x8f\x8d\xa4\x00\x3e\xda\x81\x62\xff\x5e\xc4\x96\x82\x35\x64\x15\x0e\xeb\xc3\x47\x5b\x44\xa8\x20\x07\xcd\x13\x12\xe0\x0b\x74\x1c\x8c\xa7\xb0\xd6\xb6\x86\xab\x5d\xc4\xd1\x96\x2d\xbd\x51\x51\xe8\x79\xc3\x42\xae\x86\x06\x7d\xa6\xd9\x09\x9b\x16\x31\x04\xb2\x7a\x51\x9b\xbe\x05\x97\x22\x9f\x31\xc9\x16\x9d\xc5\x44\x46\xff\x77\x53\x63\x35\x87\x8a\x03\x41\xb5\x38\x20\x3f\xda\xe8\x57\x4a\x14\x5c\xbe\x0b\x97\xeb\xf3\x47\x74\x54\x7a\x3c\x21\xe0\x36\x66\x25\x77\x49\xfe\x32\x4b\x2d\xbf\x10\xc9\x69\x11\x81\xf4\x57\x94\xa7\xea\x86\x8b\x17\xef\x9f\xf9\xc1\xbc\x8f\x09\x3a\x66\xc3\x95\xa7\xb7\x75\x7d\xe7\xf0\xf4\xff\x74\xf5\x6c\xe7\x75\xf5\x43\xd8\x8b\xc9\x56\x38\x0d\xbb\x0c\xcb\x96\x60\x79\xf7\x73\x31\x07\x29\x00\xb8\x3f\xca\x81\x65\x21\x06\xf4\x1e\xa2\xb6\x65\x14\x23\x6c\x85\x99\xfe\x85\xe5\x5d\x8b\xd0\x77\x38\xb0\xa0\x96\x2d\x5e\x6d\x74\xda\x8d\xf1\x10\xb2\x3b\xbb\xee\x23\x28\x60\x77\xee\x2a\x94\x0d\x24\x69\xd0\x4e\xb5\xcb\x0f\xd3\xf0\x7a\x23\xf4\xfa\xda\x4d\x09\x7f\xb8\x52\x1d\xd2\x4e\xbb\xd6\x77\xf0\xfe\x31\x4e\x47\xe8\xd3\x87\xe5\x37\x4f\xd9\xb3\x3d\x4b\x06\x00\x00")
+
+func tosca_simple_yaml_1_3Relationship_typesBytes() ([]byte, error) {
+	return bindataRead(
+		_tosca_simple_yaml_1_3Relationship_types,
+		"tosca_simple_yaml_1_3/relationship_types",
+	)
+}
+
+func tosca_simple_yaml_1_3Relationship_types() (*asset, error) {
+	bytes, err := tosca_simple_yaml_1_3Relationship_typesBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "tosca_simple_yaml_1_3/relationship_types", size: 1611, mode: os.FileMode(436), modTime: time.Unix(1792279540, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -287,14 +791,38 @@ func AssetNames() []string {
 
 // _bindata is a table, holding each asset generator, mapped to its name.
 var _bindata = map[string]func() (*asset, error){
-	"artifact_types":     artifact_types,
-	"capability_types":   capability_types,
-	"data_types":         data_types,
-	"group_types":        group_types,
-	"interface_types":    interface_types,
-	"node_types":         node_types,
-	"policy_types":       policy_types,
-	"relationship_types": relationship_types,
+	"tosca_simple_yaml_1_0/artifact_types":     tosca_simple_yaml_1_0Artifact_types,
+	"tosca_simple_yaml_1_0/capability_types":   tosca_simple_yaml_1_0Capability_types,
+	"tosca_simple_yaml_1_0/data_types":         tosca_simple_yaml_1_0Data_types,
+	"tosca_simple_yaml_1_0/group_types":        tosca_simple_yaml_1_0Group_types,
+	"tosca_simple_yaml_1_0/interface_types":    tosca_simple_yaml_1_0Interface_types,
+	"tosca_simple_yaml_1_0/node_types":         tosca_simple_yaml_1_0Node_types,
+	"tosca_simple_yaml_1_0/policy_types":       tosca_simple_yaml_1_0Policy_types,
+	"tosca_simple_yaml_1_0/relationship_types": tosca_simple_yaml_1_0Relationship_types,
+	"tosca_simple_yaml_1_1/artifact_types":     tosca_simple_yaml_1_1Artifact_types,
+	"tosca_simple_yaml_1_1/capability_types":   tosca_simple_yaml_1_1Capability_types,
+	"tosca_simple_yaml_1_1/data_types":         tosca_simple_yaml_1_1Data_types,
+	"tosca_simple_yaml_1_1/group_types":        tosca_simple_yaml_1_1Group_types,
+	"tosca_simple_yaml_1_1/interface_types":    tosca_simple_yaml_1_1Interface_types,
+	"tosca_simple_yaml_1_1/node_types":         tosca_simple_yaml_1_1Node_types,
+	"tosca_simple_yaml_1_1/policy_types":       tosca_simple_yaml_1_1Policy_types,
+	"tosca_simple_yaml_1_1/relationship_types": tosca_simple_yaml_1_1Relationship_types,
+	"tosca_simple_yaml_1_2/artifact_types":     tosca_simple_yaml_1_2Artifact_types,
+	"tosca_simple_yaml_1_2/capability_types":   tosca_simple_yaml_1_2Capability_types,
+	"tosca_simple_yaml_1_2/data_types":         tosca_simple_yaml_1_2Data_types,
+	"tosca_simple_yaml_1_2/group_types":        tosca_simple_yaml_1_2Group_types,
+	"tosca_simple_yaml_1_2/interface_types":    tosca_simple_yaml_1_2Interface_types,
+	"tosca_simple_yaml_1_2/node_types":         tosca_simple_yaml_1_2Node_types,
+	"tosca_simple_yaml_1_2/policy_types":       tosca_simple_yaml_1_2Policy_types,
+	"tosca_simple_yaml_1_2/relationship_types": tosca_simple_yaml_1_2Relationship_types,
+	"tosca_simple_yaml_1_3/artifact_types":     tosca_simple_yaml_1_3Artifact_types,
+	"tosca_simple_yaml_1_3/capability_types":   tosca_simple_yaml_1_3Capability_types,
+	"tosca_simple_yaml_1_3/data_types":         tosca_simple_yaml_1_3Data_types,
+	"tosca_simple_yaml_1_3/group_types":        tosca_simple_yaml_1_3Group_types,
+	"tosca_simple_yaml_1_3/interface_types":    tosca_simple_yaml_1_3Interface_types,
+	"tosca_simple_yaml_1_3/node_types":         tosca_simple_yaml_1_3Node_types,
+	"tosca_simple_yaml_1_3/policy_types":       tosca_simple_yaml_1_3Policy_types,
+	"tosca_simple_yaml_1_3/relationship_types": tosca_simple_yaml_1_3Relationship_types,
 }
 
 // AssetDir returns the file names below a certain
@@ -338,14 +866,46 @@ type bintree struct {
 }
 
 var _bintree = &bintree{nil, map[string]*bintree{
-	"artifact_types":     &bintree{artifact_types, map[string]*bintree{}},
-	"capability_types":   &bintree{capability_types, map[string]*bintree{}},
-	"data_types":         &bintree{data_types, map[string]*bintree{}},
-	"group_types":        &bintree{group_types, map[string]*bintree{}},
-	"interface_types":    &bintree{interface_types, map[string]*bintree{}},
-	"node_types":         &bintree{node_types, map[string]*bintree{}},
-	"policy_types":       &bintree{policy_types, map[string]*bintree{}},
-	"relationship_types": &bintree{relationship_types, map[string]*bintree{}},
+	"tosca_simple_yaml_1_0": &bintree{nil, map[string]*bintree{
+		"artifact_types":     &bintree{tosca_simple_yaml_1_0Artifact_types, map[string]*bintree{}},
+		"capability_types":   &bintree{tosca_simple_yaml_1_0Capability_types, map[string]*bintree{}},
+		"data_types":         &bintree{tosca_simple_yaml_1_0Data_types, map[string]*bintree{}},
+		"group_types":        &bintree{tosca_simple_yaml_1_0Group_types, map[string]*bintree{}},
+		"interface_types":    &bintree{tosca_simple_yaml_1_0Interface_types, map[string]*bintree{}},
+		"node_types":         &bintree{tosca_simple_yaml_1_0Node_types, map[string]*bintree{}},
+		"policy_types":       &bintree{tosca_simple_yaml_1_0Policy_types, map[string]*bintree{}},
+		"relationship_types": &bintree{tosca_simple_yaml_1_0Relationship_types, map[string]*bintree{}},
+	}},
+	"tosca_simple_yaml_1_1": &bintree{nil, map[string]*bintree{
+		"artifact_types":     &bintree{tosca_simple_yaml_1_1Artifact_types, map[string]*bintree{}},
+		"capability_types":   &bintree{tosca_simple_yaml_1_1Capability_types, map[string]*bintree{}},
+		"data_types":         &bintree{tosca_simple_yaml_1_1Data_types, map[string]*bintree{}},
+		"group_types":        &bintree{tosca_simple_yaml_1_1Group_types, map[string]*bintree{}},
+		"interface_types":    &bintree{tosca_simple_yaml_1_1Interface_types, map[string]*bintree{}},
+		"node_types":         &bintree{tosca_simple_yaml_1_1Node_types, map[string]*bintree{}},
+		"policy_types":       &bintree{tosca_simple_yaml_1_1Policy_types, map[string]*bintree{}},
+		"relationship_types": &bintree{tosca_simple_yaml_1_1Relationship_types, map[string]*bintree{}},
+	}},
+	"tosca_simple_yaml_1_2": &bintree{nil, map[string]*bintree{
+		"artifact_types":     &bintree{tosca_simple_yaml_1_2Artifact_types, map[string]*bintree{}},
+		"capability_types":   &bintree{tosca_simple_yaml_1_2Capability_types, map[string]*bintree{}},
+		"data_types":         &bintree{tosca_simple_yaml_1_2Data_types, map[string]*bintree{}},
+		"group_types":        &bintree{tosca_simple_yaml_1_2Group_types, map[string]*bintree{}},
+		"interface_types":    &bintree{tosca_simple_yaml_1_2Interface_types, map[string]*bintree{}},
+		"node_types":         &bintree{tosca_simple_yaml_1_2Node_types, map[string]*bintree{}},
+		"policy_types":       &bintree{tosca_simple_yaml_1_2Policy_types, map[string]*bintree{}},
+		"relationship_types": &bintree{tosca_simple_yaml_1_2Relationship_types, map[string]*bintree{}},
+	}},
+	"tosca_simple_yaml_1_3": &bintree{nil, map[string]*bintree{
+		"artifact_types":     &bintree{tosca_simple_yaml_1_3Artifact_types, map[string]*bintree{}},
+		"capability_types":   &bintree{tosca_simple_yaml_1_3Capability_types, map[string]*bintree{}},
+		"data_types":         &bintree{tosca_simple_yaml_1_3Data_types, map[string]*bintree{}},
+		"group_types":        &bintree{tosca_simple_yaml_1_3Group_types, map[string]*bintree{}},
+		"interface_types":    &bintree{tosca_simple_yaml_1_3Interface_types, map[string]*bintree{}},
+		"node_types":         &bintree{tosca_simple_yaml_1_3Node_types, map[string]*bintree{}},
+		"policy_types":       &bintree{tosca_simple_yaml_1_3Policy_types, map[string]*bintree{}},
+		"relationship_types": &bintree{tosca_simple_yaml_1_3Relationship_types, map[string]*bintree{}},
+	}},
 }}
 
 // RestoreAsset restores an asset under the given directory
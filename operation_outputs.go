@@ -0,0 +1,67 @@
+package toscalib
+
+import "sync"
+
+// OperationOutputStore records the outputs of the operations executed by an orchestrator so
+// they can be retrieved with the get_operation_output function. Entity is the name of the
+// Node or Relationship Template the operation was run for.
+type OperationOutputStore interface {
+	SetOutput(entity, intf, op, name string, value interface{})
+	GetOutput(entity, intf, op, name string) (interface{}, bool)
+}
+
+type operationOutputKey struct {
+	entity, intf, op, name string
+}
+
+// MemoryOperationOutputStore is an OperationOutputStore keeping the outputs in memory,
+// it is safe for concurrent use.
+type MemoryOperationOutputStore struct {
+	mu      sync.RWMutex
+	outputs map[operationOutputKey]interface{}
+}
+
+// NewMemoryOperationOutputStore creates an empty MemoryOperationOutputStore
+func NewMemoryOperationOutputStore() *MemoryOperationOutputStore {
+	return &MemoryOperationOutputStore{outputs: make(map[operationOutputKey]interface{})}
+}
+
+// SetOutput records the value of an operation output
+func (m *MemoryOperationOutputStore) SetOutput(entity, intf, op, name string, value interface{}) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.outputs[operationOutputKey{entity, intf, op, name}] = value
+}
+
+// GetOutput returns the value of an operation output and whether it was recorded
+func (m *MemoryOperationOutputStore) GetOutput(entity, intf, op, name string) (interface{}, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	v, ok := m.outputs[operationOutputKey{entity, intf, op, name}]
+	return v, ok
+}
+
+// SetOperationOutput records the output of an operation run for a Node or Relationship
+// Template, an in-memory store is created if none was provided.
+func (s *ServiceTemplateDefinition) SetOperationOutput(entity, intf, op, name string, value interface{}) {
+	if s.OperationOutputs == nil {
+		s.OperationOutputs = NewMemoryOperationOutputStore()
+	}
+	s.OperationOutputs.SetOutput(entity, intf, op, name, value)
+}
+
+// operationEntity returns the name of the Node or Relationship Template referenced by
+// the first argument of get_operation_output.
+func (s *ServiceTemplateDefinition) operationEntity(name, ctx string) string {
+	switch name {
+	case Self:
+		return ctx
+	case Source, Target, Host:
+		if nt := s.findNodeTemplate(name, ctx); nt != nil {
+			return nt.Name
+		}
+		return ""
+	default:
+		return name
+	}
+}
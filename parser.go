@@ -17,185 +17,483 @@ limitations under the License.
 package toscalib
 
 import (
-	"archive/zip"
+	"context"
+	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
+	"net/url"
+	"path"
 	"path/filepath"
-
-	"golang.org/x/tools/godoc/vfs"
-	"golang.org/x/tools/godoc/vfs/zipfs"
-	"gopkg.in/yaml.v2"
+	"strings"
 )
 
 // ParserHooks provide callback functions for handling custom logic at
 // key points within the overall parsing logic.
 type ParserHooks struct {
+	// ParsedSTD is called with each document once decoded, it may be nil. It receives copies of
+	// the documents of the profiles, which are decoded once and shared by the parsings when nil.
 	ParsedSTD func(source string, std *ServiceTemplateDefinition) error
+	// Conflict is called when a document redefines differently a type defined by a document
+	// loaded before, see FailOnConflict and WarnOnConflict. The parsing stops if it returns an
+	// error, when nil the last definition silently wins.
+	Conflict func(c *MergeConflict) error
 }
 
 func noop(source string, std *ServiceTemplateDefinition) error {
 	return nil
 }
 
-// ParseCsar handles open and parse the CSAR file
-func (t *ServiceTemplateDefinition) ParseCsar(zipfile string) error {
+// parsed calls the ParsedSTD hook, if any
+func (p *parser) parsed(source string, std *ServiceTemplateDefinition) error {
+	if p.hooks.ParsedSTD == nil {
+		return nil
+	}
+	return p.hooks.ParsedSTD(source, std)
+}
+
+// ErrImportCycle is returned, wrapped in an ImportCycleError, when a document imports itself
+var ErrImportCycle = errors.New("import cycle")
+
+// ImportCycleError is returned when a document imports, directly or not, a document which is
+// importing it. Chain lists the documents imported, ending with the repeated one.
+type ImportCycleError struct {
+	Chain []string
+}
+
+func (e *ImportCycleError) Error() string {
+	return fmt.Sprintf("%v: %s", ErrImportCycle, strings.Join(e.Chain, " -> "))
+}
+
+// Unwrap returns ErrImportCycle
+func (e *ImportCycleError) Unwrap() error {
+	return ErrImportCycle
+}
 
-	type meta struct {
-		Version         string `yaml:"TOSCA-Meta-File-Version"`
-		CsarVersion     string `yaml:"CSAR-Version"`
-		CreatedBy       string `yaml:"Created-By"`
-		EntryDefinition string `yaml:"Entry-Definitions"`
+// ErrDerivationCycle is returned, wrapped in a *ParseError, when a data type derives from
+// itself, directly or not
+var ErrDerivationCycle = errors.New("derivation cycle")
+
+// checkDerivations rejects the data types deriving from themselves, which can not be flattened
+func checkDerivations(std *ServiceTemplateDefinition) error {
+	for _, name := range sortedKeys(std.DataTypes) {
+		if chain := dataTypeCycle(name, *std); chain != nil {
+			path := "data_types." + chain[0] + ".derived_from"
+			return &ParseError{Location: std.locate(path), Path: path, Err: fmt.Errorf("%w: %s", ErrDerivationCycle, strings.Join(chain, " -> "))}
+		}
 	}
+	return nil
+}
 
-	rc, err := zip.OpenReader(zipfile)
-	if err != nil {
-		return err
+// parser holds the state shared while loading a document and its imports
+type parser struct {
+	ctx      context.Context
+	resolver ContextResolver
+	hooks    ParserHooks
+	archive  string
+	loaded   map[string]bool // canonical locations of the documents already loaded
+	chain    []string        // documents being imported, used to detect cycles
+	keys     []string        // canonical locations of chain
+	types    map[string]typeDefinition
+	prefixes []string          // namespace prefixes of the imports being loaded
+	spaces   map[string]string // namespaces designated by each prefix
+
+	repositories       map[string]RepositoryDefinition // repositories declared by the documents loaded
+	repositoryResolver ContextRepositoryResolver
+	profiles           *ProfileRegistry
+	profile            Profile        // profile of the document being loaded
+	shared             []profileTypes // types of the profiles loaded, shared with the cache
+}
+
+// context returns the context of the parsing, the background context when none is set
+func (p *parser) context() context.Context {
+	if p.ctx == nil {
+		return context.Background()
 	}
-	defer rc.Close()
-	fs := zipfs.New(rc, zipfile)
-	out, err := vfs.ReadFile(fs, "/TOSCA-Metadata/TOSCA.meta")
-	if err != nil {
-		return err
+	return p.ctx
+}
+
+func (p *parser) location(file string) SourceLocation {
+	return SourceLocation{Archive: p.archive, File: file}
+}
+
+// canonical returns the key identifying the location of a document, so a document imported
+// using different paths is only loaded once.
+func (p *parser) canonical(location string) string {
+	if u, err := url.Parse(location); err == nil && len(u.Scheme) > 1 {
+		if u.Path != "" {
+			u.Path = path.Clean(u.Path)
+		}
+		return u.String()
 	}
-	var m meta
-	err = yaml.Unmarshal(out, &m)
-	if err != nil {
-		return err
+	if p.archive != "" {
+		return path.Clean("/" + filepath.ToSlash(location))
+	}
+	if abs, err := filepath.Abs(location); err == nil {
+		return abs
 	}
-	dirname := fmt.Sprintf("/%v", filepath.Dir(m.EntryDefinition))
-	base := filepath.Base(m.EntryDefinition)
-	ns := vfs.NameSpace{}
-	ns.Bind("/", fs, dirname, vfs.BindReplace)
+	return filepath.Clean(location)
+}
 
-	// pass in a resolver that has the context of the virtual filespace
-	// of the archive file to handle resolving imports
-	return t.ParseSource(base, func(l string) ([]byte, error) {
-		var r []byte
-		rsc, err := ns.Open(l)
-		if err != nil {
-			return r, err
+// addRepositories makes the repositories declared by a document available to its imports, the
+// first declaration of a repository is kept.
+func (p *parser) addRepositories(repos map[string]RepositoryDefinition) {
+	for name, repo := range repos {
+		if _, ok := p.repositories[name]; !ok {
+			p.repositories[name] = repo
 		}
-		return ioutil.ReadAll(rsc)
-	}, ParserHooks{ParsedSTD: noop}) // TODO(kenjones): Add hooks as method parameter
+	}
+}
+
+// cycle returns an ImportCycleError if the document at location is being imported
+func (p *parser) cycle(location string) error {
+	key := p.canonical(location)
+	for _, k := range p.keys {
+		if k == key {
+			chain := make([]string, len(p.chain), len(p.chain)+1)
+			copy(chain, p.chain)
+			return &ImportCycleError{Chain: append(chain, location)}
+		}
+	}
+	return nil
 }
 
-func parseImports(baseDir string, impDefs []ImportDefinition, resolver Resolver, hooks ParserHooks) (ServiceTemplateDefinition, error) {
+// enter records that the document at location is being imported in the namespace scope
+func (p *parser) enter(location, scope string) {
+	key := p.canonical(location)
+	p.chain = append(p.chain, location)
+	p.keys = append(p.keys, key)
+	p.loaded[scope+key] = true
+}
+
+func (p *parser) leave() {
+	p.chain = p.chain[:len(p.chain)-1]
+	p.keys = p.keys[:len(p.keys)-1]
+}
+
+// scope returns the namespace prefixes applying to the types being loaded, for example a:b:
+func (p *parser) scope() string {
+	return strings.Join(p.prefixes, "")
+}
+
+// parseImports loads the imports of parent, depth first in the order they are listed. A document
+// imported more than once is only loaded and merged the first time. The types of an import with
+// a namespace_prefix are qualified with the prefix, see qualify.
+func (p *parser) parseImports(baseDir string, parent ServiceTemplateDefinition) (ServiceTemplateDefinition, error) {
 	var std ServiceTemplateDefinition
 
-	for _, im := range impDefs {
+	for i, im := range parent.Imports {
+		path := fmt.Sprintf("imports[%d]", i)
+		if err := p.context().Err(); err != nil {
+			// stop loading the remaining imports once the parsing is cancelled
+			return std, &ParseError{Location: parent.locate(path), Path: path, Err: err}
+		}
+		if im.Profile != "" {
+			tt, err := p.importProfile(parent, path, im)
+			if err != nil {
+				return std, err
+			}
+			std.merge(tt)
+			continue
+		}
 		imFilePath := im.File
-		if baseDir != "" {
+		var rf *RepositoryFile
+		if im.Repository != "" {
+			f, err := repositoryFile(p.repositories, im.Repository, im.File)
+			if err != nil {
+				path += ".repository"
+				return std, &ParseError{Location: parent.locate(path), Path: path, Err: err}
+			}
+			rf = &f
+			imFilePath = f.Location()
+		} else if baseDir != "" {
 			if temp := filepath.Join(baseDir, imFilePath); isAbsLocalPath(temp) {
 				imFilePath = temp
 			}
 		}
 
-		r, err := resolver(imFilePath)
+		if err := p.cycle(imFilePath); err != nil {
+			return std, &ParseError{Location: parent.locate(path), Path: path, Err: err}
+		}
+
+		scope := p.scope()
+		if im.NamespacePrefix != "" {
+			scope += im.NamespacePrefix + ":"
+			id := im.NamespaceURI
+			if id == "" {
+				id = p.canonical(imFilePath)
+			}
+			if err := p.namespace(scope, id); err != nil {
+				path += ".namespace_prefix"
+				return std, &ParseError{Location: parent.locate(path), Path: path, Err: err}
+			}
+		}
+		if p.loaded[scope+p.canonical(imFilePath)] {
+			continue
+		}
+
+		var r []byte
+		var err error
+		if rf != nil {
+			r, err = p.repositoryResolver(p.context(), *rf)
+		} else {
+			r, err = p.resolver(p.context(), imFilePath)
+		}
 		if err != nil {
-			return std, err
+			return std, &ParseError{Location: parent.locate(path), Path: path, Err: err}
 		}
 
-		var tt ServiceTemplateDefinition
-		err = yaml.Unmarshal(r, &tt)
+		p.enter(imFilePath, scope)
+		if im.NamespacePrefix != "" {
+			p.prefixes = append(p.prefixes, im.NamespacePrefix+":")
+		}
+		tt, err := p.parseImport(baseDir, imFilePath, r)
+		if im.NamespacePrefix != "" {
+			p.prefixes = p.prefixes[:len(p.prefixes)-1]
+			qualify(&tt, im.NamespacePrefix)
+		}
+		p.leave()
 		if err != nil {
 			return std, err
 		}
-		err = hooks.ParsedSTD(imFilePath, &tt)
+
+		std.merge(tt)
+	}
+
+	return std, nil
+}
+
+// parseImport decodes an imported document and merges its own imports
+func (p *parser) parseImport(baseDir, location string, data []byte) (ServiceTemplateDefinition, error) {
+	tt, err := decodeSTD(p.location(location), data)
+	if err != nil {
+		return tt, err
+	}
+	// an import not declaring its version follows the document importing it
+	parent := p.profile
+	defer func() { p.profile = parent }()
+	err = p.checkVersion(&tt)
+	if err != nil {
+		return tt, err
+	}
+	err = p.parsed(location, &tt)
+	if err != nil {
+		return tt, err
+	}
+	err = p.register(location, &tt)
+	if err != nil {
+		return tt, err
+	}
+	p.addRepositories(tt.Repositories)
+
+	if len(tt.Imports) != 0 {
+		var imptt ServiceTemplateDefinition
+		imptt, err = p.parseImports(baseDir, tt)
 		if err != nil {
-			return std, err
+			return tt, err
 		}
+		tt.merge(imptt)
+	}
+	return tt, nil
+}
 
-		if len(tt.Imports) != 0 {
-			var imptt ServiceTemplateDefinition
-			imptt, err = parseImports(baseDir, tt.Imports, resolver, hooks)
-			if err != nil {
-				return std, err
-			}
-			tt = tt.Merge(imptt)
+// importProfile loads the types of the profile an import designates by name, the first time
+// it is imported within the scope
+func (p *parser) importProfile(parent ServiceTemplateDefinition, path string, im ImportDefinition) (ServiceTemplateDefinition, error) {
+	pr, err := p.profiles.Lookup(im.Profile)
+	if err != nil {
+		path += ".profile"
+		return ServiceTemplateDefinition{}, &ParseError{Location: parent.locate(path), Path: path, Err: err}
+	}
+	scope := p.scope()
+	if im.NamespacePrefix != "" {
+		scope += im.NamespacePrefix + ":"
+		id := im.NamespaceURI
+		if id == "" {
+			id = "profile:" + pr.Name
+		}
+		if err = p.namespace(scope, id); err != nil {
+			path += ".namespace_prefix"
+			return ServiceTemplateDefinition{}, &ParseError{Location: parent.locate(path), Path: path, Err: err}
 		}
+	}
+	tt, err := p.loadProfile(pr, scope)
+	if err != nil {
+		return tt, err
+	}
+	if im.NamespacePrefix != "" {
+		qualify(&tt, im.NamespacePrefix)
+	}
+	return tt, nil
+}
 
-		std = std.Merge(tt)
+// loadProfile merges the documents of the profile, the first time it is loaded within the scope
+func (p *parser) loadProfile(pr Profile, scope string) (ServiceTemplateDefinition, error) {
+	var std ServiceTemplateDefinition
+	key := scope + "profile:" + pr.Name
+	if p.loaded[key] {
+		return std, nil
 	}
+	p.loaded[key] = true
 
+	types, err := pr.cache.load(pr.decode)
+	if err != nil {
+		return std, err
+	}
+	for i := range types.docs {
+		if err = p.register(types.docs[i].name, &types.docs[i].std); err != nil {
+			return std, err
+		}
+	}
+	if p.hooks.ParsedSTD == nil {
+		// the types are shared, the resolution reuses them once flattened
+		p.shared = append(p.shared, types)
+		return types.share(), nil
+	}
+	for _, d := range types.docs {
+		tt := d.std.Clone()
+		if err = p.hooks.ParsedSTD(d.name, &tt); err != nil {
+			return std, err
+		}
+		std.merge(tt)
+	}
 	return std, nil
 }
 
-func (t *ServiceTemplateDefinition) parse(baseDir string, data []byte, resolver Resolver, hooks ParserHooks) error {
-	var std ServiceTemplateDefinition
+// checkVersion selects the profile of the tosca_definitions_version of std, when declared, and
+// checks std follows its grammar
+func (p *parser) checkVersion(std *ServiceTemplateDefinition) error {
+	if std.DefinitionsVersion != "" {
+		pr, err := p.profiles.LookupVersion(std.DefinitionsVersion)
+		if err != nil {
+			path := "tosca_definitions_version"
+			return &ParseError{Location: std.locate(path), Path: path, Err: err}
+		}
+		p.profile = pr
+	}
+	return p.profile.check(std)
+}
+
+func (p *parser) parse(t *ServiceTemplateDefinition, source, baseDir string, data []byte) error {
+	p.loaded = make(map[string]bool)
+	p.types = make(map[string]typeDefinition)
+	p.shared = nil
+	p.profiles = t.Profiles
+	if p.profiles == nil {
+		p.profiles = DefaultProfiles
+	}
+	p.spaces = make(map[string]string)
+	if source != "" {
+		p.enter(source, "")
+	}
+
 	// Unmarshal the data in an interface
-	err := yaml.Unmarshal(data, &std)
+	std, err := decodeSTD(p.location(source), data)
 	if err != nil {
 		return err
 	}
-
-	err = hooks.ParsedSTD("", &std)
+	// a template not declaring its version follows the Simple Profile 1.0
+	p.profile = builtinProfiles[0]
+	err = p.checkVersion(&std)
 	if err != nil {
 		return err
 	}
+	version := std.DefinitionsVersion
 
-	// Import the normative types by default
-	for _, normType := range AssetNames() {
-		// the normType comes from the defined list so this will
-		// always be successful, if not then panic is the correct
-		// approach for this kind of parsing.
-		data := MustAsset(normType)
-
-		var tt ServiceTemplateDefinition
-		err = yaml.Unmarshal(data, &tt)
-		if err != nil {
-			return err
-		}
-		err = hooks.ParsedSTD(normType, &tt)
-		if err != nil {
-			return err
-		}
+	err = p.parsed("", &std)
+	if err != nil {
+		return err
+	}
+	err = p.register(source, &std)
+	if err != nil {
+		return err
+	}
+	p.repositoryResolver = t.repositoryResolver()
+	p.repositories = make(map[string]RepositoryDefinition)
+	p.addRepositories(std.Repositories)
 
-		std = std.Merge(tt)
+	// Import the types of the profile of the version by default
+	var nt ServiceTemplateDefinition
+	nt, err = p.loadProfile(p.profile, "")
+	if err != nil {
+		return err
 	}
+	std.merge(nt)
 
 	// Load all referenced Imports (recursively)
 	var tt ServiceTemplateDefinition
-	tt, err = parseImports(baseDir, std.Imports, resolver, hooks)
+	tt, err = p.parseImports(baseDir, std)
 	if err != nil {
 		return err
 	}
-	std = std.Merge(tt)
+
+	std.merge(tt)
+	std.DefinitionsVersion = version
+	if err = checkDerivations(&std); err != nil {
+		return err
+	}
 
 	// update the initial context with the freshly loaded context
+	std.RepositoryResolver = t.RepositoryResolver
+	std.ContextRepositoryResolver = t.ContextRepositoryResolver
+	std.Sandbox = t.Sandbox
+	std.Profiles = t.Profiles
 	*t = std
 
 	// resolve all references and inherited elements
-	t.resolve()
+	t.resolve(p.shared)
 
 	return nil
 }
 
+func (p *parser) parseSource(t *ServiceTemplateDefinition, source string) error {
+	baseDir := ""
+	if isAbsLocalPath(source) {
+		baseDir, _ = filepath.Split(source)
+	}
+	data, err := p.resolver(p.context(), source)
+	if err != nil {
+		return &ParseError{Location: p.location(source), Err: err}
+	}
+	return p.parse(t, source, baseDir, data)
+}
+
 // ParseReader retrieves and parses a TOSCA document and loads into the structure using
 // specified Resolver function to retrieve remote imports.
 func (t *ServiceTemplateDefinition) ParseReader(r io.Reader, resolver Resolver, hooks ParserHooks) error {
+	return t.ParseReaderContext(context.Background(), r, resolver.Context(), hooks)
+}
+
+// ParseReaderContext parses a TOSCA document like ParseReader, ctx is passed to the resolver
+// and the loading of the imports stops when it is done.
+func (t *ServiceTemplateDefinition) ParseReaderContext(ctx context.Context, r io.Reader, resolver ContextResolver, hooks ParserHooks) error {
 	data, err := ioutil.ReadAll(r)
 	if err != nil {
 		return err
 	}
-	return t.parse("", data, resolver, hooks)
+	p := &parser{ctx: ctx, resolver: resolver, hooks: hooks}
+	return p.parse(t, "", "", data)
 }
 
 // ParseSource retrieves and parses a TOSCA document and loads into the structure using
 // specified Resolver function to retrieve remote source or imports.
 func (t *ServiceTemplateDefinition) ParseSource(source string, resolver Resolver, hooks ParserHooks) error {
-	baseDir := ""
-	if isAbsLocalPath(source) {
-		baseDir, _ = filepath.Split(source)
-	}
-	data, err := resolver(source)
-	if err != nil {
-		return err
-	}
-	return t.parse(baseDir, data, resolver, hooks)
+	return t.ParseSourceContext(context.Background(), source, resolver.Context(), hooks)
+}
+
+// ParseSourceContext retrieves and parses a TOSCA document like ParseSource, ctx is passed to
+// the resolver and the loading of the imports stops when it is done.
+func (t *ServiceTemplateDefinition) ParseSourceContext(ctx context.Context, source string, resolver ContextResolver, hooks ParserHooks) error {
+	p := &parser{ctx: ctx, resolver: resolver, hooks: hooks}
+	return p.parseSource(t, source)
 }
 
 // Parse a TOSCA document and fill in the structure
 func (t *ServiceTemplateDefinition) Parse(r io.Reader) error {
-	return t.ParseReader(r, defaultResolver, ParserHooks{ParsedSTD: noop})
+	return t.ParseContext(context.Background(), r)
+}
+
+// ParseContext parses a TOSCA document like Parse, the parsing stops when ctx is done
+func (t *ServiceTemplateDefinition) ParseContext(ctx context.Context, r io.Reader) error {
+	return t.ParseReaderContext(ctx, r, DefaultContextResolver().Resolve, ParserHooks{})
 }
@@ -1,9 +1,17 @@
 package toscalib
 
 import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
+	"reflect"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestAbsToParseSource(t *testing.T) {
@@ -28,9 +36,313 @@ func TestRelativeToParseSource(t *testing.T) {
 		err := std.ParseSource(testFile, defaultResolver, ParserHooks{ParsedSTD: noop})
 		if err == nil {
 			t.Error("ParseSource:: parsing relative local TOSCA profile with wrong imports, expected pathError, actual got nil")
-		} else if !os.IsNotExist(err) {
+		} else if !errors.Is(err, os.ErrNotExist) {
 			t.Errorf("ParseSource:: parsing relative local TOSCA profile with wrong imports, expected pathError, actual %v", err.Error())
 		}
 	}
 
 }
+
+func TestParseErrorLocation(t *testing.T) {
+	fname := "tests/refapp/tosca_elk.yaml"
+	std := &ServiceTemplateDefinition{}
+	err := std.ParseSource(fname, defaultResolver, ParserHooks{ParsedSTD: noop})
+	pe, ok := err.(*ParseError)
+	if !ok {
+		t.Fatalf("ParseSource:: expected *ParseError, actual %T %v", err, err)
+	}
+	if pe.Path != "imports[0]" || pe.Location.File != fname || pe.Location.Line != 9 || pe.Location.Column != 5 {
+		t.Errorf("ParseSource:: expected error at %s:9:5 imports[0], actual %v %s", fname, pe.Location, pe.Path)
+	}
+
+	data := []byte(`tosca_definitions_version: tosca_simple_yaml_1_0
+topology_template:
+  node_templates:
+    server:
+      type: tosca.nodes.Compute
+      requirements:
+        - local_storage:
+            node: storage
+            relationship: [ not, a, relationship ]
+`)
+	p := &parser{resolver: DefaultContextResolver().Resolve, hooks: ParserHooks{ParsedSTD: noop}}
+	err = p.parse(std, "inline.yaml", "", data)
+	pe, ok = err.(*ParseError)
+	if !ok {
+		t.Fatalf("parse:: expected *ParseError, actual %T %v", err, err)
+	}
+	expected := "topology_template.node_templates.server.requirements[0].local_storage"
+	if pe.Location.File != "inline.yaml" || pe.Location.Line == 0 || !strings.HasPrefix(pe.Path, expected) {
+		t.Errorf("parse:: expected error located at %s, actual %v %s", expected, pe.Location, pe.Path)
+	}
+}
+
+func TestLocation(t *testing.T) {
+	fname := "tests/tosca_helloworld.yaml"
+	std := &ServiceTemplateDefinition{}
+	if err := std.ParseSource(fname, defaultResolver, ParserHooks{ParsedSTD: noop}); err != nil {
+		t.Fatal(err)
+	}
+
+	loc, ok := std.Location("topology_template.node_templates.my_server.capabilities.host.properties.num_cpus")
+	if !ok || loc.File != fname || loc.Line != 13 || loc.Column != 12 {
+		t.Errorf("Location:: expected %s:13:12, actual %v", fname, loc)
+	}
+
+	loc, ok = std.Location("node_types.tosca.nodes.Compute")
+	if !ok || loc.File != "tosca_simple_yaml_1_0/node_types" {
+		t.Errorf("Location:: expected normative node type location, actual %v", loc)
+	}
+
+	// the imports and the normative types do not replace the locations of the template
+	fname = "tests/test_template_with_nested_imports.yaml"
+	std = &ServiceTemplateDefinition{}
+	if err := std.ParseSource(fname, defaultResolver, ParserHooks{ParsedSTD: noop}); err != nil {
+		t.Fatal(err)
+	}
+	cases := []struct {
+		path string
+		line int
+	}{
+		{"tosca_definitions_version", 1},
+		{"description", 3},
+		{"imports[0]", 10},
+	}
+	for _, tc := range cases {
+		if loc, ok := std.Location(tc.path); !ok || loc.File != fname || loc.Line != tc.line {
+			t.Errorf("Location(%s):: expected %s:%d, actual %v", tc.path, fname, tc.line, loc)
+		}
+	}
+
+	// the values keep the YAML 1.1 semantics, only the locations come from the node tree
+	data := []byte(`tosca_definitions_version: tosca_simple_yaml_1_0
+node_types:
+  example.Switch:
+    properties:
+      enabled:
+        type: boolean
+        default: yes
+    attributes:
+      state:
+        type: map
+        default: { power: off }
+`)
+	std2, err := decodeSTD(SourceLocation{File: "inline.yaml"}, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	nt := std2.NodeTypes["example.Switch"]
+	if v := nt.Properties["enabled"].Default; v != true {
+		t.Errorf("decodeSTD:: expected default true, actual %#v", v)
+	}
+	if v, ok := nt.Attributes["state"].Default.(map[interface{}]interface{}); !ok || v["power"] != false {
+		t.Errorf("decodeSTD:: expected default map[power:false], actual %#v", nt.Attributes["state"].Default)
+	}
+	if loc, ok := std2.Location("node_types.example.Switch.properties.enabled"); !ok || loc.Line != 5 {
+		t.Errorf("Location:: expected inline.yaml:5, actual %v", loc)
+	}
+}
+
+func TestParseImports(t *testing.T) {
+	fname := "tests/imports/diamond.yaml"
+	loads := make(map[string]int)
+	resolver := func(l string) ([]byte, error) {
+		loads[l]++
+		return defaultResolver(l)
+	}
+
+	std := &ServiceTemplateDefinition{}
+	var imported []string
+	hooks := ParserHooks{ParsedSTD: func(source string, _ *ServiceTemplateDefinition) error {
+		if strings.HasPrefix(source, "tests/") {
+			imported = append(imported, source)
+		}
+		return nil
+	}}
+	if err := std.ParseSource(fname, resolver, hooks); err != nil {
+		t.Fatal(fname, err)
+	}
+	if loads["tests/imports/common.yaml"] != 1 || loads["tests/imports/./common.yaml"] != 0 {
+		t.Errorf("%s: expected common.yaml loaded once, actual %v", fname, loads)
+	}
+	expected := []string{"tests/imports/app_a.yaml", "tests/imports/common.yaml", "tests/imports/app_b.yaml"}
+	if !reflect.DeepEqual(imported, expected) {
+		t.Errorf("%s: expected imports %v, actual %v", fname, expected, imported)
+	}
+	if _, ok := std.NodeTypes["tosca.nodes.Common"]; !ok {
+		t.Errorf("%s: expected the common types to be merged", fname)
+	}
+	if std.NodeTypes["tosca.nodes.AppB"].DerivedFrom != "tosca.nodes.Common" {
+		t.Errorf("%s: expected the types of app_b.yaml to be merged", fname)
+	}
+
+	fname = "tests/imports/cycle_a.yaml"
+	err := std.ParseSource(fname, defaultResolver, ParserHooks{ParsedSTD: noop})
+	var ce *ImportCycleError
+	if !errors.Is(err, ErrImportCycle) || !errors.As(err, &ce) {
+		t.Fatalf("%s: expected an import cycle, actual %v", fname, err)
+	}
+	expected = []string{fname, "tests/imports/cycle_b.yaml", "tests/imports/cycle_c.yaml", fname}
+	if !reflect.DeepEqual(ce.Chain, expected) {
+		t.Errorf("%s: expected chain %v, actual %v", fname, expected, ce.Chain)
+	}
+	var pe *ParseError
+	if !errors.As(err, &pe) || pe.Location.File != "tests/imports/cycle_c.yaml" || pe.Path != "imports[0]" {
+		t.Errorf("%s: expected the cycle located at cycle_c.yaml imports[0], actual %v", fname, err)
+	}
+}
+
+func TestParseConflicts(t *testing.T) {
+	fname := "tests/conflicts/teams.yaml"
+	std := &ServiceTemplateDefinition{}
+	err := std.ParseSource(fname, defaultResolver, ParserHooks{ParsedSTD: noop, Conflict: FailOnConflict})
+	var mc *MergeConflict
+	if !errors.Is(err, ErrMergeConflict) || !errors.As(err, &mc) {
+		t.Fatalf("%s: expected a merge conflict, actual %v", fname, err)
+	}
+	if mc.Name != "my.nodes.Database" || mc.First.File != "tests/conflicts/team_a.yaml" || mc.Second.File != "tests/conflicts/team_b.yaml" {
+		t.Errorf("%s: expected my.nodes.Database redefined by team_b.yaml, actual %v", fname, mc)
+	}
+	if mc.First.Line != 8 || mc.Second.Line != 9 {
+		t.Errorf("%s: expected conflict located at lines 8 and 9, actual %v", fname, mc)
+	}
+
+	var buf bytes.Buffer
+	err = std.ParseSource(fname, defaultResolver, ParserHooks{ParsedSTD: noop, Conflict: WarnOnConflict(&buf)})
+	if err != nil {
+		t.Fatalf("%s: unexpected error %v", fname, err)
+	}
+	if n := strings.Count(buf.String(), "warning:"); n != 1 || !strings.Contains(buf.String(), "my.nodes.Database") {
+		t.Errorf("%s: expected a single warning for my.nodes.Database, actual %q", fname, buf.String())
+	}
+	if v := std.NodeTypes["my.nodes.Database"].Properties["engine"].Default; v != "postgresql" {
+		t.Errorf("%s: expected the last definition to win, actual %v", fname, v)
+	}
+
+	fname = "tests/conflicts/shadow.yaml"
+	err = std.ParseSource(fname, defaultResolver, ParserHooks{ParsedSTD: noop, Conflict: FailOnConflict})
+	if !errors.As(err, &mc) || mc.Name != "tosca.nodes.Compute" || mc.First.File != fname || mc.Second.File != "tosca_simple_yaml_1_0/node_types" {
+		t.Errorf("%s: expected tosca.nodes.Compute conflicting with the normative types, actual %v", fname, err)
+	}
+
+	// without policy the last definition silently wins
+	if err = std.ParseSource(fname, defaultResolver, ParserHooks{ParsedSTD: noop}); err != nil {
+		t.Errorf("%s: unexpected error %v", fname, err)
+	}
+}
+
+func TestParseNamespaces(t *testing.T) {
+	fname := "tests/namespaces/vendors.yaml"
+	std := &ServiceTemplateDefinition{}
+	err := std.ParseSource(fname, defaultResolver, ParserHooks{ParsedSTD: noop, Conflict: FailOnConflict})
+	if err != nil {
+		t.Fatal(fname, err)
+	}
+
+	for _, name := range []string{"a:Port", "a:BasePort", "b:Port"} {
+		if _, ok := std.NodeTypes[name]; !ok {
+			t.Errorf("%s: expected node type %s", fname, name)
+		}
+	}
+	if _, ok := std.NodeTypes["Port"]; ok {
+		t.Errorf("%s: expected no unqualified Port node type", fname)
+	}
+
+	port := std.NodeTypes["a:Port"]
+	if port.DerivedFrom != "a:BasePort" {
+		t.Errorf("%s: a:Port expected to derive from a:BasePort, actual %s", fname, port.DerivedFrom)
+	}
+	// references to types outside of the namespace are unchanged
+	if req := port.Requirements[0]["binding"]; req.Node != "tosca.nodes.Compute" || req.Capability != "tosca.capabilities.network.Bindable" {
+		t.Errorf("%s: a:Port binding requirement expected unchanged, actual %+v", fname, req)
+	}
+	if req := std.NodeTypes["b:Port"].Requirements[0]["link"]; req.Capability != "a:Linkable" {
+		t.Errorf("%s: b:Port link requirement expected a:Linkable, actual %+v", fname, req)
+	}
+
+	nt := std.GetNodeTemplate("port_a")
+	if nt.Refs.Type.Capabilities["link"].Type != "a:Linkable" || nt.Refs.Type.Properties["settings"].Type != "a:Settings" {
+		t.Errorf("%s: port_a expected to inherit the qualified types of a:BasePort, actual %+v", fname, nt.Refs.Type)
+	}
+	v, err := std.PropertyValue("port_a", "settings")
+	if m, ok := v.(map[string]interface{}); err != nil || !ok || m["mtu"] != 9000 {
+		t.Errorf("%s: port_a settings expected mtu 9000, actual %v %v", fname, v, err)
+	}
+	if v, err := std.PropertyValue("port_b", "speed"); err != nil || v != "10G" {
+		t.Errorf("%s: port_b speed expected 10G, actual %v %v", fname, v, err)
+	}
+	if loc, ok := std.Location("node_types.a:Port"); !ok || loc.File != "tests/namespaces/vendor_a.yaml" {
+		t.Errorf("%s: a:Port expected located in vendor_a.yaml, actual %v", fname, loc)
+	}
+	if diags := std.Validate(); len(diags) != 0 {
+		t.Errorf("%s: expected no diagnostics, actual %v", fname, diags)
+	}
+
+	// the references to the templates of a prefixed import are unchanged
+	fname = "tests/namespaces/topology.yaml"
+	std = &ServiceTemplateDefinition{}
+	if err = std.ParseSource(fname, defaultResolver, ParserHooks{ParsedSTD: noop}); err != nil {
+		t.Fatal(fname, err)
+	}
+	if req := std.NodeTypes["c:Router"].Requirements[0]["uplink"]; req.Node != "c:Router" {
+		t.Errorf("%s: c:Router uplink requirement expected c:Router, actual %+v", fname, req)
+	}
+	if nt := std.GetNodeTemplate("Router"); nt == nil || nt.Type != "c:Router" {
+		t.Errorf("%s: expected the Router node template of type c:Router, actual %+v", fname, nt)
+	}
+	if req := std.GetNodeTemplate("edge").Requirements[0]["uplink"]; req.Node != "Router" {
+		t.Errorf("%s: edge uplink requirement expected the Router node template, actual %+v", fname, req)
+	}
+	if members := std.TopologyTemplate.Groups["routers"].Members; !reflect.DeepEqual(members, []string{"Router", "edge"}) {
+		t.Errorf("%s: routers group expected the Router and edge members, actual %v", fname, members)
+	}
+	if diags := std.Validate(); len(diags) != 0 {
+		t.Errorf("%s: expected no diagnostics, actual %v", fname, diags)
+	}
+
+	fname = "tests/namespaces/collision.yaml"
+	err = std.ParseSource(fname, defaultResolver, ParserHooks{ParsedSTD: noop})
+	var pe *ParseError
+	if !errors.Is(err, ErrNamespaceCollision) || !errors.As(err, &pe) || pe.Path != "imports[1].namespace_prefix" {
+		t.Errorf("%s: expected a namespace collision at imports[1], actual %v", fname, err)
+	}
+}
+
+func TestParseContext(t *testing.T) {
+	fname := "tests/imports/diamond.yaml"
+
+	// a cancelled parsing does not load the imports
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	calls := 0
+	resolver := func(ctx context.Context, location string) ([]byte, error) {
+		calls++
+		if calls > 1 {
+			cancel()
+		}
+		return DefaultContextResolver().Resolve(ctx, location)
+	}
+
+	var s ServiceTemplateDefinition
+	err := s.ParseSourceContext(ctx, fname, resolver, ParserHooks{ParsedSTD: noop})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("ParseSourceContext(%s): expected %v, actual %v", fname, context.Canceled, err)
+	}
+	if calls != 2 {
+		t.Errorf("ParseSourceContext(%s): expected 2 documents retrieved, actual %d", fname, calls)
+	}
+
+	// the context reaches the resolver
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	defer srv.Close()
+	data := "tosca_definitions_version: tosca_simple_yaml_1_0\nimports:\n  - " + srv.URL + "/types.yaml\n"
+
+	ctx, cancelTimeout := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancelTimeout()
+	err = s.ParseReaderContext(ctx, strings.NewReader(data), DefaultContextResolver().Resolve, ParserHooks{ParsedSTD: noop})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("ParseReaderContext: expected %v, actual %v", context.DeadlineExceeded, err)
+	}
+}
@@ -88,9 +88,15 @@ func (pd *PolicyDefinition) IsValidTarget(name string) bool {
 
 func (pd *PolicyDefinition) extendFrom(pt PolicyType) {
 
-	base := pt.Triggers
-	_ = mergo.MergeWithOverwrite(&base, pd.Triggers)
-	pd.Triggers = base
+	if len(pt.Triggers) != 0 {
+		// the triggers of the type are copied, the type is shared by the templates
+		base := make(map[string]TriggerDefinition, len(pt.Triggers)+len(pd.Triggers))
+		for k, v := range pt.Triggers {
+			base[k] = v
+		}
+		_ = mergo.MergeWithOverwrite(&base, pd.Triggers)
+		pd.Triggers = base
+	}
 
 	for k, v := range pt.Properties {
 		if len(pd.Properties) == 0 {
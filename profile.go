@@ -0,0 +1,428 @@
+package toscalib
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"reflect"
+	"sort"
+	"sync"
+)
+
+// Errors returned, wrapped in a *ParseError, when a document does not follow its version or
+// imports an unknown profile
+var (
+	ErrUnsupportedVersion = errors.New("unsupported tosca_definitions_version")
+	ErrUnsupportedKeyname = errors.New("keyname not supported by the tosca_definitions_version")
+	ErrUnknownProfile     = errors.New("unknown profile")
+)
+
+// Grammar holds the differences of grammar between the versions of the Simple Profile
+type Grammar struct {
+	// InterfaceGroups lists the operations of the interfaces under the operations keyname and
+	// declares their notifications, the operations are written inline otherwise.
+	InterfaceGroups bool
+}
+
+// Profile is a named bundle of type definitions. A profile declaring a Version is the profile of
+// the documents declaring it as their tosca_definitions_version, its types are imported by
+// default. Any profile can be imported by name, see ImportDefinition.
+//
+// The types of a registered profile are decoded and resolved once, the templates parsed without
+// a ParsedSTD hook share them: Clone a template before modifying its types in place.
+type Profile struct {
+	Name    string   // The name designating the profile in the registry and in the imports.
+	Version string   // The tosca_definitions_version of the profile, such as tosca_simple_yaml_1_3, empty when only imported by name.
+	Aliases []string // The other values designating the version, such as the drafts.
+	Grammar Grammar
+	// Types holds the documents defining the types, the .yaml and .yml files at its root loaded
+	// in lexical order. Use an embed.FS, or os.DirFS to load a directory. The built-in profiles
+	// use the embedded normative types when nil.
+	Types  fs.FS
+	assets string        // the directory of the normative types among the embedded assets
+	cache  *profileCache // the types once decoded, nil when the profile is not registered
+}
+
+// builtinProfiles are the supported versions of the Simple Profile, the oldest first
+var builtinProfiles = []Profile{
+	{
+		Name:    "tosca_simple_yaml_1_0",
+		Version: "tosca_simple_yaml_1_0",
+		Aliases: []string{"tosca_simple_yaml_1_0_0", "tosca_simple_yaml_1_0_0_wd03"},
+		assets:  "tosca_simple_yaml_1_0",
+		cache:   &profileCache{},
+	},
+	{Name: "tosca_simple_yaml_1_1", Version: "tosca_simple_yaml_1_1", assets: "tosca_simple_yaml_1_1", cache: &profileCache{}},
+	{Name: "tosca_simple_yaml_1_2", Version: "tosca_simple_yaml_1_2", assets: "tosca_simple_yaml_1_2", cache: &profileCache{}},
+	{Name: "tosca_simple_yaml_1_3", Version: "tosca_simple_yaml_1_3", assets: "tosca_simple_yaml_1_3", cache: &profileCache{}, Grammar: Grammar{InterfaceGroups: true}},
+}
+
+// ProfileRegistry holds the profiles available to the parsing. It is safe for concurrent use.
+type ProfileRegistry struct {
+	mu       sync.RWMutex
+	profiles []Profile // in the order they were registered
+}
+
+// NewProfileRegistry returns a registry holding the built-in profiles, the versions of the
+// Simple Profile.
+func NewProfileRegistry() *ProfileRegistry {
+	r := &ProfileRegistry{profiles: make([]Profile, len(builtinProfiles))}
+	copy(r.profiles, builtinProfiles)
+	return r
+}
+
+// DefaultProfiles is the registry used when the Service Template does not set its own
+var DefaultProfiles = NewProfileRegistry()
+
+// RegisterProfile adds a profile to DefaultProfiles, see ProfileRegistry.Register
+func RegisterProfile(pr Profile) error {
+	return DefaultProfiles.Register(pr)
+}
+
+// LookupProfile returns the profile of DefaultProfiles for a tosca_definitions_version, or one
+// of its aliases
+func LookupProfile(version string) (Profile, error) {
+	return DefaultProfiles.LookupVersion(version)
+}
+
+// Profiles returns the profiles of DefaultProfiles, in the order they were registered
+func Profiles() []Profile {
+	return DefaultProfiles.Profiles()
+}
+
+// Register adds a profile, or replaces the profile registered with the same name. It fails when
+// the version of the profile, or one of its aliases, designates another profile. The types of
+// the profile are read and decoded once, by the first parsing using them successfully.
+func (r *ProfileRegistry) Register(pr Profile) error {
+	if pr.Name == "" {
+		return errors.New("the profile has no name")
+	}
+	pr.cache = &profileCache{}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	versions := pr.versions()
+	for _, o := range r.profiles {
+		if o.Name == pr.Name {
+			continue
+		}
+		for _, v := range o.versions() {
+			for _, w := range versions {
+				if v == w {
+					return fmt.Errorf("the version %s of the profile %s designates the profile %s", w, pr.Name, o.Name)
+				}
+			}
+		}
+	}
+	for i, o := range r.profiles {
+		if o.Name == pr.Name {
+			r.profiles[i] = pr
+			return nil
+		}
+	}
+	r.profiles = append(r.profiles, pr)
+	return nil
+}
+
+// Remove removes the profile registered with the name, if any
+func (r *ProfileRegistry) Remove(name string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, o := range r.profiles {
+		if o.Name == name {
+			r.profiles = append(r.profiles[:i], r.profiles[i+1:]...)
+			return
+		}
+	}
+}
+
+// Lookup returns the profile registered with the name
+func (r *ProfileRegistry) Lookup(name string) (Profile, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, pr := range r.profiles {
+		if pr.Name == name {
+			return pr, nil
+		}
+	}
+	return Profile{}, fmt.Errorf("%w: %s", ErrUnknownProfile, name)
+}
+
+// LookupVersion returns the profile of a tosca_definitions_version, or of one of its aliases
+func (r *ProfileRegistry) LookupVersion(version string) (Profile, error) {
+	if version == "" {
+		return Profile{}, fmt.Errorf("%w: the document does not declare its version", ErrUnsupportedVersion)
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, pr := range r.profiles {
+		for _, v := range pr.versions() {
+			if v == version {
+				return pr, nil
+			}
+		}
+	}
+	return Profile{}, fmt.Errorf("%w: %s", ErrUnsupportedVersion, version)
+}
+
+// Profiles returns the registered profiles, in the order they were registered
+func (r *ProfileRegistry) Profiles() []Profile {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	out := make([]Profile, len(r.profiles))
+	copy(out, r.profiles)
+	return out
+}
+
+// Clone returns a registry holding the same profiles, such as a copy of DefaultProfiles to
+// modify for some parsings only
+func (r *ProfileRegistry) Clone() *ProfileRegistry {
+	return &ProfileRegistry{profiles: r.Profiles()}
+}
+
+// versions returns the tosca_definitions_version values designating the profile
+func (pr Profile) versions() []string {
+	if pr.Version == "" {
+		return nil
+	}
+	return append([]string{pr.Version}, pr.Aliases...)
+}
+
+// decodedDocument is a document of a profile once decoded
+type decodedDocument struct {
+	name string
+	std  ServiceTemplateDefinition
+}
+
+// profileTypes are the documents of a profile once decoded, merged and resolved. The types are
+// flattened on their own, a profile defines the types its types refer to.
+type profileTypes struct {
+	docs  []decodedDocument
+	std   ServiceTemplateDefinition
+	flats flatTypes
+}
+
+// profileCache holds the types of a registered profile, shared by the parsings. They are never
+// modified once decoded.
+type profileCache struct {
+	mu    sync.Mutex
+	done  bool
+	types profileTypes
+}
+
+// load returns the types of the profile, decoded by decode the first time. An error is not
+// cached, the next parsing decodes the types again.
+func (c *profileCache) load(decode func() (profileTypes, error)) (profileTypes, error) {
+	if c == nil {
+		return decode()
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.done {
+		types, err := decode()
+		if err != nil {
+			return types, err
+		}
+		c.types, c.done = types, true
+	}
+	return c.types, nil
+}
+
+// decode decodes the documents of the profile, checks their grammar and resolves their types.
+// It does not depend on the registry: the documents declare the version of the profile, or of
+// one of the built-in profiles.
+func (pr Profile) decode() (profileTypes, error) {
+	var types profileTypes
+	docs, err := pr.documents()
+	if err != nil {
+		return types, fmt.Errorf("profile %s: %w", pr.Name, err)
+	}
+	versions := &ProfileRegistry{profiles: append([]Profile{pr}, builtinProfiles...)}
+	for _, d := range docs {
+		var tt ServiceTemplateDefinition
+		tt, err = decodeSTD(SourceLocation{File: d.name}, d.data)
+		if err != nil {
+			return types, err
+		}
+		// the documents not declaring their version follow the profile, or the Simple Profile 1.0
+		grammar := builtinProfiles[0]
+		if pr.Version != "" {
+			grammar = pr
+		}
+		if tt.DefinitionsVersion != "" {
+			if grammar, err = versions.LookupVersion(tt.DefinitionsVersion); err != nil {
+				path := "tosca_definitions_version"
+				return types, &ParseError{Location: tt.locate(path), Path: path, Err: err}
+			}
+		}
+		if err = grammar.check(&tt); err != nil {
+			return types, err
+		}
+		// the files the documents import are the other documents of the profile
+		tt.Imports = nil
+		types.docs = append(types.docs, decodedDocument{name: d.name, std: tt})
+		// the merge may modify the values of the documents, which are cached as decoded
+		types.std.merge(tt.Clone())
+	}
+	types.std.reflectProperties(flatTypes{})
+	types.flats = flattenHierarchy(types.std, flatTypes{})
+	return types, nil
+}
+
+// share returns a copy of the merged documents of the profile holding its own maps, the values
+// of their entries, the types, are shared
+func (types profileTypes) share() ServiceTemplateDefinition {
+	std := types.std
+	v := reflect.ValueOf(&std).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		f := v.Field(i)
+		if f.Kind() != reflect.Map || f.IsNil() || !f.CanSet() {
+			continue
+		}
+		m := reflect.MakeMapWithSize(f.Type(), f.Len())
+		for _, k := range f.MapKeys() {
+			m.SetMapIndex(k, f.MapIndex(k))
+		}
+		f.Set(m)
+	}
+	return std
+}
+
+// holds reports whether s holds every type of the profile unchanged
+func (types profileTypes) holds(s *ServiceTemplateDefinition) bool {
+	v, pv := reflect.ValueOf(s).Elem(), reflect.ValueOf(types.std)
+	for i := 0; i < v.NumField(); i++ {
+		if !isTypeSection(v.Type().Field(i)) {
+			continue
+		}
+		for _, k := range pv.Field(i).MapKeys() {
+			cur := v.Field(i).MapIndex(k)
+			if !cur.IsValid() || !reflect.DeepEqual(cur.Interface(), pv.Field(i).MapIndex(k).Interface()) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// sharedTypes returns the flattened types of the profiles s holds unchanged. The types of the
+// other profiles s shares are copied, their resolution modifies them.
+func (s *ServiceTemplateDefinition) sharedTypes(shared []profileTypes) flatTypes {
+	var base flatTypes
+	bv := reflect.ValueOf(&base).Elem()
+	v := reflect.ValueOf(s).Elem()
+	for _, types := range shared {
+		if types.holds(s) {
+			fv := reflect.ValueOf(types.flats)
+			for i := 0; i < fv.NumField(); i++ {
+				if bv.Field(i).IsNil() {
+					bv.Field(i).Set(reflect.MakeMap(fv.Field(i).Type()))
+				}
+				for _, k := range fv.Field(i).MapKeys() {
+					bv.Field(i).SetMapIndex(k, fv.Field(i).MapIndex(k))
+				}
+			}
+			continue
+		}
+		pv := reflect.ValueOf(types.std)
+		for i := 0; i < v.NumField(); i++ {
+			if !isTypeSection(v.Type().Field(i)) {
+				continue
+			}
+			for _, k := range pv.Field(i).MapKeys() {
+				cur, def := v.Field(i).MapIndex(k), pv.Field(i).MapIndex(k)
+				if cur.IsValid() && reflect.DeepEqual(cur.Interface(), def.Interface()) {
+					v.Field(i).SetMapIndex(k, reflect.ValueOf(clone(def.Interface())))
+				}
+			}
+		}
+	}
+	return base
+}
+
+// profileDocument is a document defining the types of a profile
+type profileDocument struct {
+	name string // the name of the document in the source locations
+	data []byte
+}
+
+// documents reads the documents defining the types of the profile
+func (pr Profile) documents() ([]profileDocument, error) {
+	var docs []profileDocument
+	if pr.Types == nil {
+		if pr.assets == "" {
+			return nil, nil
+		}
+		names, err := AssetDir(pr.assets)
+		if err != nil {
+			return nil, err
+		}
+		sort.Strings(names)
+		for _, n := range names {
+			n = path.Join(pr.assets, n)
+			docs = append(docs, profileDocument{name: n, data: MustAsset(n)})
+		}
+		return docs, nil
+	}
+
+	entries, err := fs.ReadDir(pr.Types, ".")
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range entries {
+		if ext := path.Ext(e.Name()); !e.Type().IsRegular() || (ext != ".yaml" && ext != ".yml") {
+			continue
+		}
+		data, err := fs.ReadFile(pr.Types, e.Name())
+		if err != nil {
+			return nil, err
+		}
+		docs = append(docs, profileDocument{name: path.Join(pr.Name, e.Name()), data: data})
+	}
+	return docs, nil
+}
+
+// check rejects the keynames of std the grammar of the profile does not define
+func (pr Profile) check(std *ServiceTemplateDefinition) error {
+	if pr.Grammar.InterfaceGroups {
+		return nil
+	}
+	for _, p := range interfacePaths(std) {
+		for _, k := range []string{"operations", "notifications"} {
+			if loc, ok := std.Locations[p+"."+k]; ok {
+				return &ParseError{Location: loc, Path: p + "." + k, Err: fmt.Errorf("%w %s: %s", ErrUnsupportedKeyname, pr.Version, k)}
+			}
+		}
+	}
+	return nil
+}
+
+// interfacePaths returns the paths of the interface types and definitions of std
+func interfacePaths(std *ServiceTemplateDefinition) []string {
+	var paths []string
+	add := func(prefix string, interfaces map[string]InterfaceDefinition) {
+		for name := range interfaces {
+			paths = append(paths, prefix+".interfaces."+name)
+		}
+	}
+	for name := range std.InterfaceTypes {
+		paths = append(paths, "interface_types."+name)
+	}
+	for name, nt := range std.NodeTypes {
+		add("node_types."+name, nt.Interfaces)
+	}
+	for name, rt := range std.RelationshipTypes {
+		add("relationship_types."+name, rt.Interfaces)
+	}
+	for name, gt := range std.GroupTypes {
+		add("group_types."+name, gt.Interfaces)
+	}
+	for name, nt := range std.TopologyTemplate.NodeTemplates {
+		add("topology_template.node_templates."+name, nt.Interfaces)
+	}
+	for name, rt := range std.TopologyTemplate.RelationshipTemplates {
+		add("topology_template.relationship_templates."+name, rt.Interfaces)
+	}
+	sort.Strings(paths)
+	return paths
+}
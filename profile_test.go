@@ -0,0 +1,377 @@
+package toscalib
+
+import (
+	"errors"
+	"os"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"testing/fstest"
+)
+
+func TestLookupProfile(t *testing.T) {
+	cases := []struct {
+		version  string
+		expected string
+	}{
+		{"tosca_simple_yaml_1_0", "tosca_simple_yaml_1_0"},
+		{"tosca_simple_yaml_1_0_0", "tosca_simple_yaml_1_0"},
+		{"tosca_simple_yaml_1_0_0_wd03", "tosca_simple_yaml_1_0"},
+		{"tosca_simple_yaml_1_1", "tosca_simple_yaml_1_1"},
+		{"tosca_simple_yaml_1_2", "tosca_simple_yaml_1_2"},
+		{"tosca_simple_yaml_1_3", "tosca_simple_yaml_1_3"},
+		{"tosca_simple_yaml_2_0", ""},
+		{"tosca_2_0", ""},
+		{"", ""},
+	}
+	for _, tc := range cases {
+		pr, err := LookupProfile(tc.version)
+		if tc.expected == "" {
+			if !errors.Is(err, ErrUnsupportedVersion) {
+				t.Errorf("LookupProfile(%q): expected ErrUnsupportedVersion, actual %v", tc.version, err)
+			}
+			continue
+		}
+		if err != nil || pr.Version != tc.expected {
+			t.Errorf("LookupProfile(%q): expected %s, actual %s %v", tc.version, tc.expected, pr.Version, err)
+		}
+		if docs, err := pr.documents(); err != nil || len(docs) != 8 {
+			t.Errorf("LookupProfile(%q): expected 8 normative documents, actual %d %v", tc.version, len(docs), err)
+		}
+	}
+}
+
+func TestParseProfiles(t *testing.T) {
+	fname := "./tests/tosca_simple_yaml_1_3.yaml"
+	var s ServiceTemplateDefinition
+	if err := s.ParseSource(fname, defaultResolver, ParserHooks{ParsedSTD: noop}); err != nil {
+		t.Fatal(err)
+	}
+	if s.DefinitionsVersion != "tosca_simple_yaml_1_3" {
+		t.Errorf("%s: expected the version of the template, actual %s", fname, s.DefinitionsVersion)
+	}
+	if c := s.NodeTypes["tosca.nodes.Compute"]; c.DerivedFrom != "tosca.nodes.Abstract.Compute" {
+		t.Errorf("%s: expected tosca.nodes.Compute derived from tosca.nodes.Abstract.Compute, actual %q", fname, c.DerivedFrom)
+	}
+	if _, ok := s.InterfaceTypes["tosca.interfaces.node.lifecycle.Standard"].Operations["create"]; !ok {
+		t.Errorf("%s: expected the grouped operations of the normative interface types", fname)
+	}
+	intf := s.TopologyTemplate.NodeTemplates["server"].Interfaces
+	if _, ok := intf["Standard"].Operations["create"]; !ok {
+		t.Errorf("%s: expected the grouped create operation, actual %+v", fname, intf["Standard"])
+	}
+	if _, ok := s.InterfaceTypes["org.example.interfaces.Scaling"].Notifications["scaled"]; !ok {
+		t.Errorf("%s: expected the scaled notification, actual %+v", fname, s.InterfaceTypes["org.example.interfaces.Scaling"])
+	}
+
+	fname = "./tests/tosca_helloworld.yaml"
+	s = ServiceTemplateDefinition{}
+	if err := s.ParseSource(fname, defaultResolver, ParserHooks{ParsedSTD: noop}); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := s.NodeTypes["tosca.nodes.Abstract.Compute"]; ok {
+		t.Errorf("%s: expected the normative types of tosca_simple_yaml_1_0 only", fname)
+	}
+
+	invalids := []struct {
+		data string
+		path string
+		err  error
+	}{
+		{
+			data: "tosca_definitions_version: tosca_simple_yaml_2_0\n",
+			path: "tosca_definitions_version",
+			err:  ErrUnsupportedVersion,
+		},
+		{
+			data: `tosca_definitions_version: tosca_simple_yaml_1_0
+interface_types:
+  org.example.interfaces.Scaling:
+    derived_from: tosca.interfaces.Root
+    notifications:
+      scaled:
+        description: Sent once the instances are running.
+`,
+			path: "interface_types.org.example.interfaces.Scaling.notifications",
+			err:  ErrUnsupportedKeyname,
+		},
+		{
+			data: `tosca_definitions_version: tosca_simple_yaml_1_2
+topology_template:
+  node_templates:
+    server:
+      type: tosca.nodes.Compute
+      interfaces:
+        Standard:
+          operations:
+            create: scripts/create.sh
+`,
+			path: "topology_template.node_templates.server.interfaces.Standard.operations",
+			err:  ErrUnsupportedKeyname,
+		},
+	}
+	for _, tc := range invalids {
+		p := &parser{resolver: DefaultContextResolver().Resolve, hooks: ParserHooks{ParsedSTD: noop}}
+		err := p.parse(&ServiceTemplateDefinition{}, "inline.yaml", "", []byte(tc.data))
+		pe, ok := err.(*ParseError)
+		if !ok || !errors.Is(err, tc.err) {
+			t.Errorf("parse:: expected %v, actual %T %v", tc.err, err, err)
+			continue
+		}
+		if pe.Path != tc.path || pe.Location.Line == 0 {
+			t.Errorf("parse:: expected error located at %s, actual %v %s", tc.path, pe.Location, pe.Path)
+		}
+	}
+}
+
+func TestProfileRegistry(t *testing.T) {
+	reg := DefaultProfiles.Clone()
+	example := Profile{Name: "org.example.profile", Types: os.DirFS("tests/profiles/example")}
+	if err := reg.Register(example); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LookupProfile("org.example.profile"); err == nil {
+		t.Errorf("Register: expected DefaultProfiles unchanged")
+	}
+	if err := reg.Register(Profile{Name: "org.example.other", Version: "tosca_simple_yaml_1_3"}); err == nil {
+		t.Errorf("Register: expected the version of another profile rejected")
+	}
+
+	// a patched Simple Profile 1.0 keeping a few normative types only
+	patched := Profile{
+		Name:    "tosca_simple_yaml_1_0",
+		Version: "tosca_simple_yaml_1_0",
+		Types: fstest.MapFS{"node_types.yaml": {Data: []byte(`tosca_definitions_version: tosca_simple_yaml_1_0
+node_types:
+  tosca.nodes.Root:
+    description: The TOSCA root node all other TOSCA base node types derive from.
+  tosca.nodes.Compute:
+    derived_from: tosca.nodes.Root
+    properties:
+      flavor:
+        type: string
+        default: small
+`)}},
+	}
+	if err := reg.Register(patched); err != nil {
+		t.Fatal(err)
+	}
+
+	data := `tosca_definitions_version: tosca_simple_yaml_1_3
+imports:
+  - profile: org.example.profile
+  - profile: org.example.profile
+    namespace_prefix: ex
+topology_template:
+  node_templates:
+    vnf:
+      type: org.example.nodes.VNF
+`
+	s := ServiceTemplateDefinition{Profiles: reg}
+	if err := s.ParseReader(strings.NewReader(data), defaultResolver, ParserHooks{ParsedSTD: noop}); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := s.NodeTypes["ex:org.example.nodes.VNF"]; !ok {
+		t.Errorf("ParseReader: expected the types of the profile imported with a prefix")
+	}
+	if _, ok := s.DataTypes["org.example.datatypes.Version"]; !ok {
+		t.Errorf("ParseReader: expected the types of every document of the profile")
+	}
+	if loc, ok := s.Location("node_types.org.example.nodes.VNF"); !ok || loc.File != "org.example.profile/example_nodes.yaml" {
+		t.Errorf("Location: expected the document of the profile, actual %v", loc)
+	}
+	if s.Profiles != reg {
+		t.Errorf("ParseReader: expected the registry set before parsing kept")
+	}
+	// the registry is shared by the copies
+	if c := s.Clone(); c.Profiles != reg || len(c.NodeTypes) != len(s.NodeTypes) {
+		t.Errorf("Clone: expected the registry shared, actual %p", c.Profiles)
+	}
+	if m := s.Merge(ServiceTemplateDefinition{Description: "merged"}); m.Profiles != reg || m.Description != "merged" {
+		t.Errorf("Merge: expected the registry shared, actual %p", m.Profiles)
+	}
+
+	data = `tosca_definitions_version: tosca_simple_yaml_1_0
+topology_template:
+  node_templates:
+    server:
+      type: tosca.nodes.Compute
+`
+	s = ServiceTemplateDefinition{Profiles: reg}
+	if err := s.ParseReader(strings.NewReader(data), defaultResolver, ParserHooks{ParsedSTD: noop}); err != nil {
+		t.Fatal(err)
+	}
+	if len(s.NodeTypes) != 2 || s.NodeTypes["tosca.nodes.Compute"].Properties["flavor"].Default != "small" {
+		t.Errorf("ParseReader: expected the patched normative types only, actual %d node types", len(s.NodeTypes))
+	}
+
+	data = "tosca_definitions_version: tosca_simple_yaml_1_0\nimports:\n  - profile: org.example.profile\n"
+	s = ServiceTemplateDefinition{}
+	err := s.ParseReader(strings.NewReader(data), defaultResolver, ParserHooks{ParsedSTD: noop})
+	pe, ok := err.(*ParseError)
+	if !ok || !errors.Is(err, ErrUnknownProfile) || pe.Path != "imports[0].profile" {
+		t.Errorf("ParseReader: expected ErrUnknownProfile at imports[0].profile, actual %T %v", err, err)
+	}
+}
+
+func TestProfileCache(t *testing.T) {
+	fname := "./tests/tosca_helloworld.yaml"
+	root := "tosca.nodes.Root"
+
+	// the hooks receive copies of the cached documents
+	hooks := ParserHooks{ParsedSTD: func(source string, std *ServiceTemplateDefinition) error {
+		if nt, ok := std.NodeTypes[root]; ok {
+			nt.Description = "patched"
+			std.NodeTypes[root] = nt
+		}
+		return nil
+	}}
+	var s ServiceTemplateDefinition
+	if err := s.ParseSource(fname, defaultResolver, hooks); err != nil {
+		t.Fatal(err)
+	}
+	if s.NodeTypes[root].Description != "patched" {
+		t.Errorf("ParseSource(%s): expected the types modified by the hook, actual %q", fname, s.NodeTypes[root].Description)
+	}
+
+	var wg sync.WaitGroup
+	results := make([]ServiceTemplateDefinition, 4)
+	errs := make([]error, len(results))
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = results[i].ParseSource(fname, defaultResolver, ParserHooks{})
+		}(i)
+	}
+	wg.Wait()
+	for i, s := range results {
+		if errs[i] != nil {
+			t.Fatal(errs[i])
+		}
+		if d := s.NodeTypes[root].Description; d == "patched" || d == "" {
+			t.Errorf("ParseSource(%s): expected the cached types unmodified, actual %q", fname, d)
+		}
+		if _, ok := s.TopologyTemplate.NodeTemplates["my_server"]; !ok {
+			t.Errorf("ParseSource(%s): expected the node templates", fname)
+		}
+	}
+
+	pr, err := DefaultProfiles.Lookup("tosca_simple_yaml_1_0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pr.cache.types.docs) != 8 {
+		t.Errorf("Lookup: expected the cached documents of the profile, actual %d", len(pr.cache.types.docs))
+	}
+	// the parsings share the types resolved once
+	storage := "tosca.nodes.Storage.BlockStorage"
+	shared := reflect.ValueOf(pr.cache.types.std.NodeTypes[storage].Properties).Pointer()
+	for i, s := range results {
+		if reflect.ValueOf(s.NodeTypes[storage].Properties).Pointer() != shared {
+			t.Errorf("ParseSource(%s): expected the types of the profile shared by parsing %d", fname, i)
+		}
+	}
+
+	// a type of the profile redefined by an import is resolved on a copy
+	cached, flats := pr.cache.types.std.Clone(), clone(pr.cache.types.flats)
+	fname = "./tests/conflicts/shadow_import.yaml"
+	s = ServiceTemplateDefinition{}
+	if err = s.ParseSource(fname, defaultResolver, ParserHooks{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := s.GetNodeTemplate("server").Refs.Type.Capabilities["host"]; ok {
+		t.Errorf("ParseSource(%s): expected the redefined tosca.nodes.Compute", fname)
+	}
+	if !reflect.DeepEqual(cached, pr.cache.types.std) || !reflect.DeepEqual(flats, pr.cache.types.flats) {
+		t.Errorf("ParseSource(%s): expected the cached types unmodified", fname)
+	}
+}
+
+func TestProfileTemplates(t *testing.T) {
+	fname := "./tests/tosca_helloworld.yaml"
+	pr, err := DefaultProfiles.Lookup("tosca_simple_yaml_1_0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the templates modified in place do not modify the types shared with the next parsings
+	var s ServiceTemplateDefinition
+	if err = s.ParseSource(fname, defaultResolver, ParserHooks{}); err != nil {
+		t.Fatal(err)
+	}
+	cached, flats := pr.cache.types.std.Clone(), clone(pr.cache.types.flats)
+	n := s.GetNodeTemplate("my_server")
+	intf, ok := n.Interfaces["Standard"]
+	if !ok || len(intf.Operations) == 0 {
+		t.Fatalf("ParseSource(%s): expected the Standard interface inherited by my_server, actual %v", fname, n.Interfaces)
+	}
+	for k, op := range intf.Operations {
+		op.Implementation = "patched.sh"
+		if op.Inputs == nil {
+			op.Inputs = make(map[string]PropertyAssignment)
+		}
+		op.Inputs["patched"] = PropertyAssignment{}
+		intf.Operations[k] = op
+	}
+	intf.Operations["patched"] = OperationDefinition{Implementation: "patched.sh"}
+	n.Refs.Type.Capabilities["host"].Properties["patched"] = PropertyDefinition{Type: TypeString}
+
+	if !reflect.DeepEqual(cached, pr.cache.types.std) || !reflect.DeepEqual(flats, pr.cache.types.flats) {
+		t.Errorf("ParseSource(%s): expected the cached types unmodified", fname)
+	}
+	s = ServiceTemplateDefinition{}
+	if err = s.ParseSource(fname, defaultResolver, ParserHooks{}); err != nil {
+		t.Fatal(err)
+	}
+	n = s.GetNodeTemplate("my_server")
+	for k, op := range n.Interfaces["Standard"].Operations {
+		if _, ok := op.Inputs["patched"]; ok || k == "patched" || op.Implementation == "patched.sh" {
+			t.Errorf("ParseSource(%s): expected the operation %s unmodified, actual %+v", fname, k, op)
+		}
+	}
+	if _, ok := n.Refs.Type.Capabilities["host"].Properties["patched"]; ok {
+		t.Errorf("ParseSource(%s): expected the capabilities of the type unmodified", fname)
+	}
+}
+
+func TestProfileDecoding(t *testing.T) {
+	// the decoding does not depend on the registry of the first parsing
+	reg := DefaultProfiles.Clone()
+	reg.Remove("tosca_simple_yaml_1_3")
+	types := fstest.MapFS{"types.yaml": {Data: []byte(`tosca_definitions_version: tosca_simple_yaml_1_3
+node_types: [ not, a, map ]
+`)}}
+	if err := reg.Register(Profile{Name: "org.example.apps", Types: types}); err != nil {
+		t.Fatal(err)
+	}
+
+	data := `tosca_definitions_version: tosca_simple_yaml_1_0
+imports:
+  - profile: org.example.apps
+topology_template:
+  node_templates:
+    app:
+      type: org.example.nodes.App
+`
+	s := ServiceTemplateDefinition{Profiles: reg}
+	err := s.ParseReader(strings.NewReader(data), defaultResolver, ParserHooks{})
+	if _, ok := err.(*ParseError); !ok {
+		t.Fatalf("ParseReader: expected a *ParseError, actual %T %v", err, err)
+	}
+
+	// the errors are not cached
+	types["types.yaml"] = &fstest.MapFile{Data: []byte(`tosca_definitions_version: tosca_simple_yaml_1_3
+node_types:
+  org.example.nodes.App:
+    derived_from: tosca.nodes.Root
+`)}
+	s = ServiceTemplateDefinition{Profiles: reg}
+	if err = s.ParseReader(strings.NewReader(data), defaultResolver, ParserHooks{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := s.NodeTypes["org.example.nodes.App"]; !ok {
+		t.Errorf("ParseReader: expected the types of the profile once fixed")
+	}
+}
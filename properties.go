@@ -33,7 +33,7 @@ type PropertyDefinition struct {
 	Type        string             `yaml:"type" json:"type"`                                   // The required data type for the property
 	Description string             `yaml:"description,omitempty" json:"description,omitempty"` // The optional description for the property.
 	Required    bool               `yaml:"required,omitempty" json:"required,omitempty"`       // An optional key that declares a property as required ( true) or not ( false) Default: true
-	Default     string             `yaml:"default,omitempty" json:"default,omitempty"`
+	Default     interface{}        `yaml:"default,omitempty" json:"default,omitempty"`         // An optional key that may provide a value to be used as a default if not provided by another means.
 	Status      Status             `yaml:"status,omitempty" json:"status,omitempty"`
 	Constraints Constraints        `yaml:"constraints,omitempty,flow" json:"constraints,omitempty"`
 	EntrySchema interface{}        `yaml:"entry_schema,omitempty" json:"entry_schema,omitempty"`
@@ -48,21 +48,22 @@ func (p *PropertyDefinition) UnmarshalYAML(unmarshal func(interface{}) error) er
 		return nil
 	}
 	var test2 struct {
-		Value       PropertyAssignment     `yaml:"value,omitempty"`
-		Type        string                 `yaml:"type" json:"type"`                                   // The required data type for the property
-		Description string                 `yaml:"description,omitempty" json:"description,omitempty"` // The optional description for the property.
-		Required    bool                   `yaml:"required,omitempty" json:"required,omitempty"`       // An optional key that declares a property as required ( true) or not ( false) Default: true
-		Default     string                 `yaml:"default,omitempty" json:"default,omitempty"`
-		Status      Status                 `yaml:"status,omitempty" json:"status,omitempty"`
-		Constraints Constraints            `yaml:"constraints,omitempty,flow" json:"constraints,omitempty"`
-		EntrySchema map[string]interface{} `yaml:"entry_schema,omitempty" json:"entry_schema,omitempty"`
+		Value       PropertyAssignment `yaml:"value,omitempty"`
+		Type        string             `yaml:"type" json:"type"`                                   // The required data type for the property
+		Description string             `yaml:"description,omitempty" json:"description,omitempty"` // The optional description for the property.
+		Required    *bool              `yaml:"required,omitempty" json:"required,omitempty"`       // An optional key that declares a property as required ( true) or not ( false) Default: true
+		Default     interface{}        `yaml:"default,omitempty" json:"default,omitempty"`
+		Status      Status             `yaml:"status,omitempty" json:"status,omitempty"`
+		Constraints Constraints        `yaml:"constraints,omitempty,flow" json:"constraints,omitempty"`
+		EntrySchema interface{}        `yaml:"entry_schema,omitempty" json:"entry_schema,omitempty"`
 	}
 	err := unmarshal(&test2)
 	if err == nil {
 		p.Value = test2.Value
 		p.Type = test2.Type
 		p.Description = test2.Description
-		p.Required = test2.Required
+		// as per the specification a property is required unless stated otherwise
+		p.Required = test2.Required == nil || *test2.Required
 		p.Default = test2.Default
 		p.Status = test2.Status
 		p.Constraints = test2.Constraints
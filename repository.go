@@ -0,0 +1,197 @@
+package toscalib
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// DefaultHTTPTimeout bounds the downloads of the default resolvers
+const DefaultHTTPTimeout = 30 * time.Second
+
+// defaultHTTPClient downloads the documents and the repository files when no client is given
+var defaultHTTPClient = &http.Client{Timeout: DefaultHTTPTimeout}
+
+// ErrRepositoryNotFound is returned when an import or an artifact references an undefined repository
+var ErrRepositoryNotFound = errors.New("repository not found")
+
+// RepositoryFile designates a file stored in a repository declared by a Service Template
+type RepositoryFile struct {
+	Repository string               // The name of the repository.
+	URL        string               // The URL of the repository.
+	Credential CredentialDefinition // The credential used to access the repository.
+	File       string               // The path of the file relative to the URL of the repository.
+}
+
+// Location returns the URL of the repository joined with the path of the file
+func (f RepositoryFile) Location() string {
+	if u, err := url.Parse(f.File); err == nil && len(u.Scheme) > 1 {
+		// the file is already an absolute URL
+		return f.File
+	}
+	u, err := url.Parse(f.URL)
+	if err != nil || len(u.Scheme) <= 1 {
+		// the repository is a local directory
+		return filepath.Join(f.URL, filepath.FromSlash(f.File))
+	}
+	u.Path = path.Join("/", u.Path, f.File)
+	return u.String()
+}
+
+// RepositoryResolver retrieves the content of a file stored in a repository, it receives the
+// credential of the repository to authorize the access.
+type RepositoryResolver func(f RepositoryFile) ([]byte, error)
+
+// ContextRepositoryResolver is a RepositoryResolver which can be cancelled through its context
+type ContextRepositoryResolver func(ctx context.Context, f RepositoryFile) ([]byte, error)
+
+// Context returns a ContextRepositoryResolver calling r unless the context is done, r itself
+// cannot be interrupted.
+func (r RepositoryResolver) Context() ContextRepositoryResolver {
+	return func(ctx context.Context, f RepositoryFile) ([]byte, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return r(f)
+	}
+}
+
+// DirRepositoryResolver returns a RepositoryResolver reading the files of each repository
+// from the sub directory of root named after the repository, whatever its URL. It is meant
+// for working offline, for example in tests. The files must be within the directory of their
+// repository.
+func DirRepositoryResolver(root string) RepositoryResolver {
+	return func(f RepositoryFile) ([]byte, error) {
+		base, err := realDir(root, "")
+		if err != nil {
+			return nil, err
+		}
+		dir, err := within(base, filepath.FromSlash(f.Repository))
+		if err != nil {
+			return nil, err
+		}
+		return readWithin(dir, f.File)
+	}
+}
+
+// defaultRepositoryResolver downloads the files of http(s) repositories using their credential,
+// other locations are read from the local file system. The download is cancelled when ctx is done.
+func defaultRepositoryResolver(ctx context.Context, f RepositoryFile) ([]byte, error) {
+	location := f.Location()
+	u, err := url.Parse(location)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+		// a local directory, the file must be within it
+		dir, err := realDir(f.URL, "")
+		if err != nil {
+			return nil, err
+		}
+		return readWithin(dir, f.File)
+	}
+
+	req, err := http.NewRequest(http.MethodGet, location, nil)
+	if err != nil {
+		return nil, err
+	}
+	client := defaultHTTPClient
+	if f.Credential.Token != "" {
+		if !f.contains(u) {
+			// a file outside the repository, such as an absolute URL, is not sent the credential
+			return nil, fmt.Errorf("%w: %s is outside of repository %s", ErrUnsafePath, location, f.Repository)
+		}
+		f.Credential.authorize(req)
+		c := *defaultHTTPClient
+		c.CheckRedirect = func(r *http.Request, via []*http.Request) error {
+			if !f.contains(r.URL) {
+				return fmt.Errorf("%w: redirected outside of repository %s to %s", ErrUnsafePath, f.Repository, r.URL)
+			}
+			if len(via) >= 10 {
+				return errors.New("stopped after 10 redirects")
+			}
+			return nil
+		}
+		client = &c
+	}
+
+	res, err := client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("retrieving %s from repository %s: %s", f.File, f.Repository, res.Status)
+	}
+	return ioutil.ReadAll(res.Body)
+}
+
+// contains reports if u designates a location under the URL of the repository
+func (f RepositoryFile) contains(u *url.URL) bool {
+	base, err := url.Parse(f.URL)
+	if err != nil || !strings.EqualFold(base.Scheme, u.Scheme) || !strings.EqualFold(base.Host, u.Host) {
+		return false
+	}
+	dir := path.Join("/", base.Path)
+	p := path.Join("/", u.Path)
+	return dir == "/" || p == dir || strings.HasPrefix(p, dir+"/")
+}
+
+// authorize adds the credential to a request according to its token_type: password (the
+// default) and basic_auth use the basic authentication, bearer an authorization token and any
+// other token type is used as the name of the header carrying the token, as in X-Auth-Token.
+func (c CredentialDefinition) authorize(req *http.Request) {
+	if c.Token == "" {
+		return
+	}
+	switch strings.ToLower(c.TokenType) {
+	case "", "password":
+		req.SetBasicAuth(c.User, c.Token)
+	case "basic_auth":
+		// the token holds the user and the password
+		if i := strings.Index(c.Token, ":"); i >= 0 {
+			req.SetBasicAuth(c.Token[:i], c.Token[i+1:])
+		} else {
+			req.SetBasicAuth(c.User, c.Token)
+		}
+	case "bearer":
+		req.Header.Set("Authorization", "Bearer "+c.Token)
+	default:
+		req.Header.Set(c.TokenType, c.Token)
+	}
+}
+
+// repositoryFile looks up the repository named repo in repos and designates file within it
+func repositoryFile(repos map[string]RepositoryDefinition, repo, file string) (RepositoryFile, error) {
+	def, ok := repos[repo]
+	if !ok {
+		return RepositoryFile{}, fmt.Errorf("%w: %s", ErrRepositoryNotFound, repo)
+	}
+	return RepositoryFile{Repository: repo, URL: def.URL, Credential: def.Credential, File: file}, nil
+}
+
+// repositoryResolver returns the resolver of the repository files of the Service Template, the
+// ContextRepositoryResolver takes precedence over the RepositoryResolver.
+func (s *ServiceTemplateDefinition) repositoryResolver() ContextRepositoryResolver {
+	if s.ContextRepositoryResolver != nil {
+		return s.ContextRepositoryResolver
+	}
+	if s.RepositoryResolver != nil {
+		return s.RepositoryResolver.Context()
+	}
+	return defaultRepositoryResolver
+}
+
+// resolveRepositoryFile retrieves file from the repository named repo using the resolver of the
+// Service Template, the retrieval is cancelled when ctx is done.
+func (s *ServiceTemplateDefinition) resolveRepositoryFile(ctx context.Context, repo, file string) ([]byte, error) {
+	f, err := repositoryFile(s.Repositories, repo, file)
+	if err != nil {
+		return nil, err
+	}
+	return s.repositoryResolver()(ctx, f)
+}
@@ -0,0 +1,200 @@
+package toscalib
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+)
+
+func TestRepositoryFileLocation(t *testing.T) {
+	testCases := []struct {
+		f        RepositoryFile
+		expected string
+	}{
+		{RepositoryFile{URL: "https://repo.example.com/tosca/", File: "types.yaml"}, "https://repo.example.com/tosca/types.yaml"},
+		{RepositoryFile{URL: "https://repo.example.com", File: "/types/nodes.yaml"}, "https://repo.example.com/types/nodes.yaml"},
+		{RepositoryFile{URL: "https://repo.example.com/tosca", File: "http://other.example.com/types.yaml"}, "http://other.example.com/types.yaml"},
+		{RepositoryFile{URL: "tests/repositories/vendor", File: "types.yaml"}, "tests/repositories/vendor/types.yaml"},
+	}
+	for _, tc := range testCases {
+		if l := tc.f.Location(); l != tc.expected {
+			t.Errorf("%s + %s: expected %s, actual %s", tc.f.URL, tc.f.File, tc.expected, l)
+		}
+	}
+}
+
+func TestParseRepositoryImports(t *testing.T) {
+	fname := "tests/repositories/repository_imports.yaml"
+	var requested []RepositoryFile
+	resolver := DirRepositoryResolver("tests/repositories")
+
+	std := &ServiceTemplateDefinition{}
+	std.RepositoryResolver = func(f RepositoryFile) ([]byte, error) {
+		requested = append(requested, f)
+		return resolver(f)
+	}
+	if err := std.ParseSource(fname, defaultResolver, ParserHooks{ParsedSTD: noop}); err != nil {
+		t.Fatal(fname, err)
+	}
+	if _, ok := std.NodeTypes["vendor.nodes.App"]; !ok {
+		t.Fatalf("%s: expected the types imported from the vendor repository", fname)
+	}
+	if len(requested) != 1 || requested[0].Location() != "https://repo.example.com/tosca/types.yaml" {
+		t.Fatalf("%s: expected types.yaml requested from the vendor repository, actual %v", fname, requested)
+	}
+	if cred := requested[0].Credential; cred.User != "deployer" || cred.Token != "secret" {
+		t.Errorf("%s: expected the credential of the vendor repository, actual %+v", fname, cred)
+	}
+
+	// artifacts are retrieved through the same resolver
+	dir, err := ioutil.TempDir("", "toscalib")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	pa := Assignment{Function: GetArtifactFunc, Args: []interface{}{Self, "installer", dir}}
+	v, err := pa.EvaluateE(std, "app")
+	if err != nil {
+		t.Fatalf("%s: get_artifact failed %v", fname, err)
+	}
+	if data, err := ioutil.ReadFile(v.(string)); err != nil || string(data) != "#!/bin/sh\necho installing\n" {
+		t.Errorf("%s: unexpected artifact %v %q %v", fname, v, data, err)
+	}
+	if len(requested) != 2 || requested[1].File != "scripts/install.sh" {
+		t.Errorf("%s: expected scripts/install.sh requested from the vendor repository, actual %v", fname, requested)
+	}
+
+	fname = "tests/invalids/tosca_unknown_repository.yaml"
+	err = std.ParseSource(fname, defaultResolver, ParserHooks{ParsedSTD: noop})
+	var pe *ParseError
+	if !errors.Is(err, ErrRepositoryNotFound) || !errors.As(err, &pe) || pe.Path != "imports[0].repository" {
+		t.Errorf("%s: expected an unknown repository at imports[0], actual %v", fname, err)
+	}
+}
+
+func TestRepositoryRedirect(t *testing.T) {
+	var leaked string
+	other := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		leaked = r.Header.Get("X-Auth-Token")
+		w.Write([]byte("content"))
+	}))
+	defer other.Close()
+	ts := httptest.NewServer(http.RedirectHandler(other.URL+"/types.yaml", http.StatusFound))
+	defer ts.Close()
+
+	f := RepositoryFile{Repository: "repo", URL: ts.URL + "/tosca", Credential: CredentialDefinition{TokenType: "X-Auth-Token", Token: "604bbe45"}, File: "types.yaml"}
+	if _, err := defaultRepositoryResolver(context.Background(), f); !errors.Is(err, ErrUnsafePath) {
+		t.Errorf("expected the redirection outside of the repository rejected, actual %v", err)
+	}
+	if leaked != "" {
+		t.Errorf("expected no credential sent outside of the repository, actual %q", leaked)
+	}
+}
+
+func TestRepositoryCredentials(t *testing.T) {
+	testCases := []struct {
+		cred   CredentialDefinition
+		header string
+		value  string
+	}{
+		{CredentialDefinition{User: "user", Token: "pass"}, "Authorization", "Basic dXNlcjpwYXNz"},
+		{CredentialDefinition{TokenType: "basic_auth", Token: "user:pass"}, "Authorization", "Basic dXNlcjpwYXNz"},
+		{CredentialDefinition{TokenType: "bearer", Token: "abc"}, "Authorization", "Bearer abc"},
+		{CredentialDefinition{TokenType: "X-Auth-Token", Token: "604bbe45"}, "X-Auth-Token", "604bbe45"},
+		{CredentialDefinition{}, "Authorization", ""},
+	}
+
+	for _, tc := range testCases {
+		var actual string
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			actual = r.Header.Get(tc.header)
+			if r.URL.Path != "/tosca/types.yaml" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.Write([]byte("content"))
+		}))
+
+		f := RepositoryFile{Repository: "repo", URL: ts.URL + "/tosca", Credential: tc.cred, File: "types.yaml"}
+		data, err := defaultRepositoryResolver(context.Background(), f)
+		if err != nil || string(data) != "content" {
+			t.Errorf("%+v: unexpected response %q %v", tc.cred, data, err)
+		}
+		if actual != tc.value {
+			t.Errorf("%+v: expected %s %q, actual %q", tc.cred, tc.header, tc.value, actual)
+		}
+
+		f.File = "missing.yaml"
+		if _, err = defaultRepositoryResolver(context.Background(), f); err == nil {
+			t.Errorf("%+v: expected an error for a missing file", tc.cred)
+		}
+
+		// the credential is only sent within the repository
+		actual = ""
+		for _, file := range []string{ts.URL + "/other/types.yaml", ts.URL + "/tosca/../types.yaml", "http://other.example.com/tosca/types.yaml"} {
+			f.File = file
+			_, err = defaultRepositoryResolver(context.Background(), f)
+			if tc.cred.Token != "" && !errors.Is(err, ErrUnsafePath) {
+				t.Errorf("%+v: expected %s rejected, actual %v", tc.cred, file, err)
+			}
+			if actual != "" {
+				t.Errorf("%+v: expected no credential sent to %s, actual %q", tc.cred, file, actual)
+			}
+		}
+		ts.Close()
+	}
+}
+
+func TestRepositoryResolverContext(t *testing.T) {
+	fname := "tests/repositories/repository_imports.yaml"
+	type key struct{}
+	resolver := DirRepositoryResolver("tests/repositories")
+
+	var received []interface{}
+	std := &ServiceTemplateDefinition{}
+	std.ContextRepositoryResolver = func(ctx context.Context, f RepositoryFile) ([]byte, error) {
+		received = append(received, ctx.Value(key{}))
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return resolver(f)
+	}
+	ctx := context.WithValue(context.Background(), key{}, "parse")
+	if err := std.ParseSourceContext(ctx, fname, DefaultContextResolver().Resolve, ParserHooks{ParsedSTD: noop}); err != nil {
+		t.Fatal(fname, err)
+	}
+	if len(received) != 1 || received[0] != "parse" {
+		t.Fatalf("%s: expected the import resolved with the context of the parsing, actual %v", fname, received)
+	}
+
+	// get_artifact is cancelled with the context of the evaluation
+	dir, err := ioutil.TempDir("", "toscalib")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	pa := Assignment{Function: GetArtifactFunc, Args: []interface{}{Self, "installer", dir}}
+	ctx, cancel := context.WithCancel(context.WithValue(context.Background(), key{}, "evaluation"))
+	cancel()
+	if _, err = pa.EvaluateContext(ctx, std, "app"); !errors.Is(err, context.Canceled) {
+		t.Errorf("%s: expected %v, actual %v", fname, context.Canceled, err)
+	}
+	if len(received) != 2 || received[1] != "evaluation" {
+		t.Errorf("%s: expected the artifact resolved with the context of the evaluation, actual %v", fname, received)
+	}
+
+	// the RepositoryResolver is not called once the context is done
+	calls := 0
+	std.ContextRepositoryResolver = nil
+	std.RepositoryResolver = func(f RepositoryFile) ([]byte, error) {
+		calls++
+		return resolver(f)
+	}
+	if _, err = pa.EvaluateContext(ctx, std, "app"); !errors.Is(err, context.Canceled) || calls != 0 {
+		t.Errorf("%s: expected %v without calling the resolver, actual %v after %d calls", fname, context.Canceled, err, calls)
+	}
+}
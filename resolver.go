@@ -1,44 +1,245 @@
 package toscalib
 
 import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
 	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
 )
 
 // Resolver defines a function spec that the Parser will use to resolve
 // remote Imports.
 type Resolver func(string) ([]byte, error)
 
-// DefaultResolver provides a basic implementation for retrieving imports that reference
-// remote locations. The file will be downloaded over HTTP(s) and the contents are returned.
-func defaultResolver(location string) ([]byte, error) {
-	var r []byte
+// ContextResolver is a Resolver which can be cancelled through its context
+type ContextResolver func(ctx context.Context, location string) ([]byte, error)
+
+// Errors returned by the resolvers
+var (
+	ErrUnsupportedScheme = errors.New("unsupported scheme")
+	ErrTooLarge          = errors.New("document too large")
+)
+
+// Context returns a ContextResolver calling r unless the context is done, r itself cannot be
+// interrupted.
+func (r Resolver) Context() ContextResolver {
+	return func(ctx context.Context, location string) ([]byte, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return r(location)
+	}
+}
 
-	u, err := url.Parse(location)
+// Resolver returns a Resolver calling r with ctx
+func (r ContextResolver) Resolver(ctx context.Context) Resolver {
+	return func(location string) ([]byte, error) {
+		return r(ctx, location)
+	}
+}
+
+// WithTimeout returns a ContextResolver which cancels r when it takes longer than timeout
+func (r ContextResolver) WithTimeout(timeout time.Duration) ContextResolver {
+	return func(ctx context.Context, location string) ([]byte, error) {
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		return r(ctx, location)
+	}
+}
+
+// SchemeResolver dispatches each location to the resolver registered for the scheme of its URL,
+// the resolver registered for "" handles the locations without scheme, such as local paths.
+type SchemeResolver map[string]ContextResolver
+
+// Resolve is the ContextResolver of s
+func (s SchemeResolver) Resolve(ctx context.Context, location string) ([]byte, error) {
+	scheme := ""
+	if u, err := url.Parse(location); err == nil && len(u.Scheme) > 1 {
+		// a single letter is a windows drive
+		scheme = strings.ToLower(u.Scheme)
+	}
+	r, ok := s[scheme]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedScheme, location)
+	}
+	return r(ctx, location)
+}
+
+// Resolver returns a Resolver dispatching the locations with ctx
+func (s SchemeResolver) Resolver(ctx context.Context) Resolver {
+	return ContextResolver(s.Resolve).Resolver(ctx)
+}
+
+// readAll reads r up to maxSize bytes, there is no limit when maxSize is 0 or less
+func readAll(r io.Reader, maxSize int64, location string) ([]byte, error) {
+	if maxSize <= 0 {
+		return ioutil.ReadAll(r)
+	}
+	data, err := ioutil.ReadAll(io.LimitReader(r, maxSize+1))
 	if err != nil {
-		return r, err
+		return nil, err
 	}
+	if int64(len(data)) > maxSize {
+		return nil, fmt.Errorf("%w: %s exceeds %d bytes", ErrTooLarge, location, maxSize)
+	}
+	return data, nil
+}
 
-	switch u.Scheme {
-	case "http", "https":
-		var res *http.Response
-		res, err = http.Get(u.String())
+// HTTPResolver returns a ContextResolver downloading http(s) locations with client, or a
+// client timing out after DefaultHTTPTimeout when nil. Documents larger than maxSize bytes are
+// rejected when maxSize is positive.
+func HTTPResolver(client *http.Client, maxSize int64) ContextResolver {
+	if client == nil {
+		client = defaultHTTPClient
+	}
+	return func(ctx context.Context, location string) ([]byte, error) {
+		req, err := http.NewRequest(http.MethodGet, location, nil)
 		if err != nil {
-			return r, err
+			return nil, err
+		}
+		res, err := client.Do(req.WithContext(ctx))
+		if err != nil {
+			return nil, err
 		}
 		defer res.Body.Close()
-		r, err = ioutil.ReadAll(res.Body)
+		if res.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("retrieving %s: %s", location, res.Status)
+		}
+		return readAll(res.Body, maxSize, location)
+	}
+}
+
+// FileResolver returns a ContextResolver reading local paths and file:// URLs. Documents larger
+// than maxSize bytes are rejected when maxSize is positive.
+func FileResolver(maxSize int64) ContextResolver {
+	return func(ctx context.Context, location string) ([]byte, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if strings.HasPrefix(strings.ToLower(location), "file:") {
+			u, err := url.Parse(location)
+			if err != nil {
+				return nil, err
+			}
+			location = filepath.FromSlash(u.Path)
+		}
+		f, err := os.Open(location)
 		if err != nil {
-			return r, err
+			return nil, err
 		}
-		return r, nil
-	default:
-		r, err = ioutil.ReadFile(location)
+		defer f.Close()
+		return readAll(f, maxSize, location)
+	}
+}
+
+// DataResolver decodes data: URLs as described by RFC 2397, for example
+// data:text/plain;base64,SGVsbG8= or data:,Hello
+func DataResolver(ctx context.Context, location string) ([]byte, error) {
+	i := strings.Index(location, ",")
+	if !strings.HasPrefix(strings.ToLower(location), "data:") || i < 0 {
+		return nil, fmt.Errorf("invalid data URL %.32s", location)
+	}
+	params, data := location[len("data:"):i], location[i+1:]
+	if strings.HasSuffix(strings.ToLower(params), ";base64") {
+		return base64.StdEncoding.DecodeString(data)
+	}
+	s, err := url.PathUnescape(data)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(s), nil
+}
+
+// MirrorResolver returns a ContextResolver reading remote locations from a local copy, the
+// document at https://host/path is read from root/host/path whatever the scheme. The documents
+// must be within root.
+func MirrorResolver(root string) ContextResolver {
+	return func(ctx context.Context, location string) ([]byte, error) {
+		u, err := url.Parse(location)
+		if err != nil {
+			return nil, err
+		}
+		if u.Host == "" {
+			return nil, fmt.Errorf("%s has no host to look up in the mirror", location)
+		}
+		dir, err := realDir(root, "")
+		if err != nil {
+			return nil, err
+		}
+		return readWithin(dir, filepath.Join(u.Host, filepath.FromSlash(u.Path)))
+	}
+}
+
+// CacheResolver returns a ContextResolver keeping the documents retrieved by r in dir, a document
+// already in dir is not retrieved again. The files of dir are named after the hash of their location.
+func CacheResolver(dir string, r ContextResolver) ContextResolver {
+	return func(ctx context.Context, location string) ([]byte, error) {
+		sum := sha256.Sum256([]byte(location))
+		name := filepath.Join(dir, hex.EncodeToString(sum[:]))
+		if data, err := ioutil.ReadFile(name); err == nil {
+			return data, nil
+		}
+
+		data, err := r(ctx, location)
 		if err != nil {
-			return r, err
+			return nil, err
+		}
+		if err = os.MkdirAll(dir, 0755); err != nil {
+			return nil, err
+		}
+		// write to a temporary file first so a partial document is never read from the cache
+		tmp, err := ioutil.TempFile(dir, "tmp-")
+		if err != nil {
+			return nil, err
+		}
+		_, err = io.Copy(tmp, bytes.NewReader(data))
+		if cerr := tmp.Close(); err == nil {
+			err = cerr
 		}
-		return r, nil
+		if err == nil {
+			err = os.Rename(tmp.Name(), name)
+		}
+		if err != nil {
+			os.Remove(tmp.Name())
+			return nil, err
+		}
+		return data, nil
 	}
+}
 
+// DefaultContextResolver handles local paths and the file, data, http and https schemes
+func DefaultContextResolver() SchemeResolver {
+	return SchemeResolver{
+		"":      FileResolver(0),
+		"file":  FileResolver(0),
+		"data":  DataResolver,
+		"http":  HTTPResolver(nil, 0),
+		"https": HTTPResolver(nil, 0),
+	}
+}
+
+// OfflineResolver behaves like DefaultContextResolver but reads the http(s) locations from the
+// mirror directory, see MirrorResolver.
+func OfflineResolver(mirror string) SchemeResolver {
+	r := DefaultContextResolver()
+	r["http"] = MirrorResolver(mirror)
+	r["https"] = MirrorResolver(mirror)
+	return r
+}
+
+// DefaultResolver provides a basic implementation for retrieving imports that reference
+// remote locations. The file will be downloaded over HTTP(s) and the contents are returned.
+func defaultResolver(location string) ([]byte, error) {
+	return DefaultContextResolver().Resolve(context.Background(), location)
 }
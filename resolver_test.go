@@ -0,0 +1,131 @@
+package toscalib
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSchemeResolver(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "remote")
+	}))
+	defer srv.Close()
+
+	abs, _ := filepath.Abs("tests/tosca_helloworld.yaml")
+	local, _ := ioutil.ReadFile(abs)
+
+	r := DefaultContextResolver()
+	var testCases = []struct {
+		location string
+		expected string
+		err      error
+	}{
+		{"data:,Hello%2C%20World", "Hello, World", nil},
+		{"data:text/plain;base64,SGVsbG8=", "Hello", nil},
+		{"tests/tosca_helloworld.yaml", string(local), nil},
+		{"file://" + filepath.ToSlash(abs), string(local), nil},
+		{srv.URL + "/types.yaml", "remote", nil},
+		{"ftp://example.com/types.yaml", "", ErrUnsupportedScheme},
+	}
+
+	for _, tc := range testCases {
+		data, err := r.Resolve(context.Background(), tc.location)
+		if tc.err != nil {
+			if !errors.Is(err, tc.err) {
+				t.Errorf("Resolve(%s): expected %v, actual %v", tc.location, tc.err, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Resolve(%s): %v", tc.location, err)
+		} else if string(data) != tc.expected {
+			t.Errorf("Resolve(%s): expected %q, actual %q", tc.location, tc.expected, data)
+		}
+	}
+}
+
+func TestResolverLimits(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/slow" {
+			select {
+			case <-r.Context().Done():
+			case <-time.After(5 * time.Second):
+			}
+			return
+		}
+		fmt.Fprint(w, strings.Repeat("x", 100))
+	}))
+	defer srv.Close()
+
+	if _, err := HTTPResolver(nil, 10)(context.Background(), srv.URL); !errors.Is(err, ErrTooLarge) {
+		t.Errorf("HTTPResolver: expected %v, actual %v", ErrTooLarge, err)
+	}
+	if data, err := HTTPResolver(nil, 100)(context.Background(), srv.URL); err != nil || len(data) != 100 {
+		t.Errorf("HTTPResolver: expected 100 bytes, actual %d %v", len(data), err)
+	}
+	if _, err := FileResolver(10)(context.Background(), "tests/tosca_helloworld.yaml"); !errors.Is(err, ErrTooLarge) {
+		t.Errorf("FileResolver: expected %v, actual %v", ErrTooLarge, err)
+	}
+
+	r := HTTPResolver(nil, 0).WithTimeout(50 * time.Millisecond)
+	if _, err := r(context.Background(), srv.URL+"/slow"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("WithTimeout: expected %v, actual %v", context.DeadlineExceeded, err)
+	}
+}
+
+func TestCacheResolver(t *testing.T) {
+	dir, err := ioutil.TempDir("", "toscalib-cache")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	calls := 0
+	r := CacheResolver(dir, func(ctx context.Context, location string) ([]byte, error) {
+		calls++
+		return []byte(location), nil
+	})
+
+	for i := 0; i < 2; i++ {
+		for _, location := range []string{"http://example.com/a.yaml", "http://example.com/b.yaml"} {
+			data, err := r(context.Background(), location)
+			if err != nil || string(data) != location {
+				t.Errorf("CacheResolver(%s): expected %q, actual %q %v", location, location, data, err)
+			}
+		}
+	}
+	if calls != 2 {
+		t.Errorf("CacheResolver: expected 2 retrievals, actual %d", calls)
+	}
+}
+
+func TestOfflineResolver(t *testing.T) {
+	fname := "tests/tosca_single_instance_wordpress_with_url_import.yaml"
+	r := OfflineResolver("tests/mirror").Resolver(context.Background())
+
+	var s ServiceTemplateDefinition
+	if err := s.ParseSource(fname, r, ParserHooks{ParsedSTD: noop}); err != nil {
+		t.Fatalf("ParseSource(%s) offline: %v", fname, err)
+	}
+	if _, ok := s.NodeTypes["tosca.nodes.WebApplication.WordPress"]; !ok {
+		t.Errorf("ParseSource(%s) offline: missing the imported tosca.nodes.WebApplication.WordPress", fname)
+	}
+
+	if _, err := MirrorResolver("tests/mirror")(context.Background(), "https://example.com/missing.yaml"); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("MirrorResolver: expected %v, actual %v", os.ErrNotExist, err)
+	}
+	for _, location := range []string{"https://example.com/../../tosca_helloworld.yaml", "https://../tosca_helloworld.yaml", "https://example.com/%2e%2e/%2e%2e/tosca_helloworld.yaml"} {
+		if _, err := MirrorResolver("tests/mirror")(context.Background(), location); !errors.Is(err, ErrUnsafePath) {
+			t.Errorf("MirrorResolver(%s): expected %v, actual %v", location, ErrUnsafePath, err)
+		}
+	}
+}
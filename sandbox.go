@@ -0,0 +1,149 @@
+package toscalib
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ErrUnsafePath is returned, wrapped, when a file designated by a template or an archive is
+// outside of the directory it is confined to.
+var ErrUnsafePath = errors.New("unsafe path")
+
+// DefaultMaxArtifactSize is the size of the largest artifact copied by get_artifact, unless set
+// by the Sandbox of the Service Template.
+const DefaultMaxArtifactSize = 100 << 20
+
+// Sandbox confines the files read and written by get_artifact. The zero value reads the
+// artifact files within the current directory and writes them within the temporary directory.
+type Sandbox struct {
+	Root        string // The directory the artifact files must be within, the relative files are relative to it.
+	OutputDir   string // The directory the artifacts are copied to, the location argument and the deploy_path must be within it.
+	MaxFileSize int64  // The size of the largest artifact, DefaultMaxArtifactSize when 0 and no limit when negative.
+}
+
+func (s Sandbox) root() (string, error) {
+	return realDir(s.Root, ".")
+}
+
+func (s Sandbox) outputDir() (string, error) {
+	return realDir(s.OutputDir, os.TempDir())
+}
+
+func (s Sandbox) maxFileSize() int64 {
+	if s.MaxFileSize == 0 {
+		return DefaultMaxArtifactSize
+	}
+	return s.MaxFileSize
+}
+
+// realDir returns the absolute path of dir, or def when empty, with its symbolic links resolved
+func realDir(dir, def string) (string, error) {
+	if dir == "" {
+		dir = def
+	}
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+	return filepath.EvalSymlinks(abs)
+}
+
+// within returns the path of name, relative to dir when not absolute, with its symbolic links
+// resolved. It fails when the path is outside of dir, which symbolic links are resolved, as
+// written once the links of its existing parents are resolved or once fully resolved.
+func within(dir, name string) (string, error) {
+	p := name
+	if !filepath.IsAbs(p) {
+		p = filepath.Join(dir, p)
+	}
+	if !inside(dir, resolveParents(filepath.Clean(p))) {
+		return "", fmt.Errorf("%w: %s is outside of %s", ErrUnsafePath, name, dir)
+	}
+	real, err := filepath.EvalSymlinks(p)
+	if err != nil {
+		return "", err
+	}
+	if !inside(dir, real) {
+		return "", fmt.Errorf("%w: %s links outside of %s", ErrUnsafePath, name, dir)
+	}
+	return real, nil
+}
+
+// resolveParents returns the clean path p with the symbolic links of its longest existing
+// parent resolved, the missing elements are kept as written.
+func resolveParents(p string) string {
+	missing := ""
+	for {
+		if real, err := filepath.EvalSymlinks(p); err == nil {
+			return filepath.Join(real, missing)
+		}
+		parent := filepath.Dir(p)
+		if parent == p {
+			return filepath.Join(p, missing)
+		}
+		missing = filepath.Join(filepath.Base(p), missing)
+		p = parent
+	}
+}
+
+// inside reports if the path p is dir or within it
+func inside(dir, p string) bool {
+	rel, err := filepath.Rel(dir, p)
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+// readWithin reads the file name, relative to dir even when absolute, which must be within dir
+func readWithin(dir, name string) ([]byte, error) {
+	name, err := within(dir, filepath.FromSlash(strings.TrimLeft(name, "/")))
+	if err != nil {
+		return nil, err
+	}
+	return ioutil.ReadFile(name)
+}
+
+// readFile reads the artifact file src within the root of the sandbox
+func (s Sandbox) readFile(src string) ([]byte, error) {
+	root, err := s.root()
+	if err != nil {
+		return nil, err
+	}
+	src, err = within(root, src)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(src)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return readAll(f, s.maxFileSize(), src)
+}
+
+// writeFile writes data to the file named filename in destDir, which must be within the output
+// directory of the sandbox, and returns its absolute path. An existing symbolic link is not
+// followed.
+func (s Sandbox) writeFile(filename string, data []byte, destDir string) (string, error) {
+	if max := s.maxFileSize(); max > 0 && int64(len(data)) > max {
+		return "", fmt.Errorf("%w: %s exceeds %d bytes", ErrTooLarge, filename, max)
+	}
+	if filename == "." || filename == ".." || strings.ContainsAny(filename, `/\`) {
+		return "", fmt.Errorf("%w: invalid file name %s", ErrUnsafePath, filename)
+	}
+	out, err := s.outputDir()
+	if err != nil {
+		return "", err
+	}
+	dir, err := within(out, destDir)
+	if err != nil {
+		return "", err
+	}
+	dest := filepath.Join(dir, filename)
+	if fi, err := os.Lstat(dest); err == nil && fi.Mode()&os.ModeSymlink != 0 {
+		return "", fmt.Errorf("%w: %s is a symbolic link", ErrUnsafePath, dest)
+	}
+	return writeFile(filename, data, dir)
+}
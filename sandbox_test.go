@@ -0,0 +1,115 @@
+package toscalib
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestSandbox(t *testing.T) {
+	dir, err := ioutil.TempDir("", "toscalib")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	out := filepath.Join(dir, "out")
+	if err = os.Mkdir(out, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err = os.Symlink(filepath.Join(dir, "victim"), filepath.Join(out, "link.txt")); err != nil {
+		t.Fatal(err)
+	}
+
+	// the output directory may be designated through a symbolic link, as the temporary directory
+	linked := filepath.Join(dir, "linked")
+	if err = os.Symlink(out, linked); err != nil {
+		t.Fatal(err)
+	}
+
+	outside, err := filepath.Abs("tests/files/my_db_content.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = os.Symlink(outside, filepath.Join(dir, "escape.txt")); err != nil {
+		t.Fatal(err)
+	}
+
+	fname := "./tests/test_get_artifact.yaml"
+	// the sandbox is kept by the parsing
+	s := ServiceTemplateDefinition{Sandbox: Sandbox{OutputDir: out}}
+	if err = s.ParseSource(fname, defaultResolver, ParserHooks{ParsedSTD: noop}); err != nil {
+		t.Fatal(err)
+	}
+	if s.Sandbox.OutputDir != out {
+		t.Errorf("%s: expected the sandbox set before parsing, actual %+v", fname, s.Sandbox)
+	}
+	content, err := ioutil.ReadFile("tests/files/my_db_content.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		name     string
+		file     string
+		location string
+		sandbox  Sandbox
+		err      error
+	}{
+		{"relative file", "tests/files/my_db_content.txt", out, Sandbox{OutputDir: out}, nil},
+		{"relative location", "tests/files/my_db_content.txt", "out", Sandbox{OutputDir: dir}, nil},
+		{"linked output dir", "tests/files/my_db_content.txt", linked, Sandbox{OutputDir: linked}, nil},
+		{"linked location", "tests/files/my_db_content.txt", linked, Sandbox{OutputDir: out}, nil},
+		{"missing location", "tests/files/my_db_content.txt", filepath.Join(linked, "missing"), Sandbox{OutputDir: out}, os.ErrNotExist},
+		{"parent file", "../README.md", out, Sandbox{OutputDir: out}, ErrUnsafePath},
+		{"absolute file", "/etc/hosts", out, Sandbox{OutputDir: out}, ErrUnsafePath},
+		{"root", "files/my_db_content.txt", out, Sandbox{Root: "tests", OutputDir: out}, nil},
+		{"outside root", "../tosca_helloworld.yaml", out, Sandbox{Root: "tests/files", OutputDir: out}, ErrUnsafePath},
+		{"link outside root", "escape.txt", out, Sandbox{Root: dir, OutputDir: out}, ErrUnsafePath},
+		{"parent location", "tests/files/my_db_content.txt", filepath.Join(out, ".."), Sandbox{OutputDir: out}, ErrUnsafePath},
+		{"default location", "tests/files/my_db_content.txt", "/etc", Sandbox{}, ErrUnsafePath},
+		{"too large", "tests/files/my_db_content.txt", out, Sandbox{OutputDir: out, MaxFileSize: 4}, ErrTooLarge},
+	}
+	for _, tc := range testCases {
+		s.Sandbox = tc.sandbox
+		at := s.TopologyTemplate.NodeTemplates["my_db"].Artifacts["db_content"]
+		at.File = tc.file
+		s.TopologyTemplate.NodeTemplates["my_db"].Artifacts["db_content"] = at
+
+		pa := Assignment{Function: GetArtifactFunc, Args: []interface{}{Self, "db_content", tc.location}}
+		v, err := pa.EvaluateE(&s, "my_db")
+		if tc.err != nil {
+			if !errors.Is(err, tc.err) {
+				t.Errorf("%s: expected %v, actual %v %v", tc.name, tc.err, v, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: %v", tc.name, err)
+			continue
+		}
+		if data, err := ioutil.ReadFile(v.(string)); err != nil || string(data) != string(content) {
+			t.Errorf("%s: unexpected artifact %v %q %v", tc.name, v, data, err)
+		}
+	}
+
+	// an existing symbolic link is not followed
+	if _, err = (Sandbox{OutputDir: out}).writeFile("link.txt", []byte("data"), out); !errors.Is(err, ErrUnsafePath) {
+		t.Errorf("symbolic link: expected %v, actual %v", ErrUnsafePath, err)
+	}
+	if _, err = os.Stat(filepath.Join(dir, "victim")); !os.IsNotExist(err) {
+		t.Errorf("symbolic link: the target of the link was written")
+	}
+
+	// the repositories are confined to their directory
+	r := DirRepositoryResolver("tests/repositories")
+	for _, f := range []RepositoryFile{
+		{Repository: "vendor", File: "../../tosca_helloworld.yaml"},
+		{Repository: "..", File: "tosca_helloworld.yaml"},
+	} {
+		if _, err = r(f); !errors.Is(err, ErrUnsafePath) {
+			t.Errorf("DirRepositoryResolver(%s, %s): expected %v, actual %v", f.Repository, f.File, ErrUnsafePath, err)
+		}
+	}
+}
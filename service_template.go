@@ -16,7 +16,12 @@ limitations under the License.
 
 package toscalib
 
-import "github.com/kenjones-cisco/mergo"
+import (
+	"fmt"
+	"sort"
+
+	"github.com/kenjones-cisco/mergo"
+)
 
 // ServiceTemplateDefinition is the meta structure containing an entire tosca document as described in
 // http://docs.oasis-open.org/tosca/TOSCA-Simple-Profile-YAML/v1.0/csd03/TOSCA-Simple-Profile-YAML-v1.0-csd03.html
@@ -36,51 +41,111 @@ type ServiceTemplateDefinition struct {
 	GroupTypes         map[string]GroupType            `yaml:"group_types,omitempty" json:"group_types,omitempty"`
 	PolicyTypes        map[string]PolicyType           `yaml:"policy_types" json:"policy_types"`
 	TopologyTemplate   TopologyTemplateType            `yaml:"topology_template" json:"topology_template"` // Defines the topology template of an application or service, consisting of node templates that represent the application’s or service’s components, as well as relationship templates representing relations between the components.
+	Locations          map[string]SourceLocation       `yaml:"-" json:"-"`                                 // Where each element was defined, keyed by its dotted path within the document.
+	OperationOutputs   OperationOutputStore            `yaml:"-" json:"-"`                                 // Outputs of the operations run by the orchestrator, used to evaluate get_operation_output.
+	RepositoryResolver RepositoryResolver              `yaml:"-" json:"-"`                                 // Retrieves the imports and artifacts stored in repositories, set it before parsing. Files are downloaded over HTTP(s) when nil.
+	Sandbox            Sandbox                         `yaml:"-" json:"-"`                                 // Confines the files read and written by get_artifact.
+	Profiles           *ProfileRegistry                `yaml:"-" json:"-"`                                 // The profiles available to the parsing, set it before parsing. DefaultProfiles when nil.
+
+	// ContextRepositoryResolver replaces the RepositoryResolver when set, the retrievals are
+	// cancelled with the context of the parsing or of the evaluation.
+	ContextRepositoryResolver ContextRepositoryResolver `yaml:"-" json:"-"`
+
+	dataTypes map[string]DataType // the data types flattened by resolve, never modified
 }
 
-func (s *ServiceTemplateDefinition) resolve() {
+// resolve reflects the properties and flattens the types, the types of the profiles shared
+// with the template are already resolved.
+func (s *ServiceTemplateDefinition) resolve(shared []profileTypes) {
+	base := s.sharedTypes(shared)
+
 	// reflect properties to attributes
-	s.reflectProperties()
+	s.reflectProperties(base)
 
 	// resolve inherited data
-	ft := flattenHierarchy(*s)
+	ft := flattenHierarchy(*s, base)
 	s.TopologyTemplate.extendFrom(ft)
+	s.dataTypes = ft.DataTypes
 }
 
-func (s *ServiceTemplateDefinition) reflectProperties() {
+// reflectProperties reflects the properties of the types, except those of base, and of the
+// templates to attributes
+func (s *ServiceTemplateDefinition) reflectProperties(base flatTypes) {
 	for k, v := range s.CapabilityTypes {
+		if _, ok := base.Capabilities[k]; ok {
+			continue
+		}
 		v.reflectProperties()
 		s.CapabilityTypes[k] = v
 	}
 
 	for k, v := range s.RelationshipTypes {
+		if _, ok := base.Relationships[k]; ok {
+			continue
+		}
 		v.reflectProperties()
 		s.RelationshipTypes[k] = v
 	}
 
 	for k, v := range s.NodeTypes {
+		if _, ok := base.Nodes[k]; ok {
+			continue
+		}
 		v.reflectProperties()
 		s.NodeTypes[k] = v
 	}
 
+	for k, v := range s.GroupTypes {
+		if _, ok := base.Groups[k]; ok {
+			continue
+		}
+		v.reflectProperties()
+		s.GroupTypes[k] = v
+	}
+
 	s.TopologyTemplate.reflectProperties()
 }
 
 // Clone creates a deep copy of a Service Template Definition
 func (s *ServiceTemplateDefinition) Clone() ServiceTemplateDefinition {
 	var ns ServiceTemplateDefinition
+	// the operation output store holds runtime state and the profile registry is safe for
+	// concurrent use, they are shared and not copied, as the flattened data types
+	store, profiles, dataTypes := s.OperationOutputs, s.Profiles, s.dataTypes
+	s.OperationOutputs, s.Profiles, s.dataTypes = nil, nil, nil
 	tmp := clone(*s)
+	s.OperationOutputs, s.Profiles, s.dataTypes = store, profiles, dataTypes
 	ns, _ = tmp.(ServiceTemplateDefinition)
+	ns.OperationOutputs, ns.Profiles, ns.dataTypes = store, profiles, dataTypes
 	return ns
 }
 
 // Merge applies the data from one ServiceTemplate to the current ServiceTemplate
 func (s *ServiceTemplateDefinition) Merge(u ServiceTemplateDefinition) ServiceTemplateDefinition {
 	std := s.Clone()
-	_ = mergo.MergeWithOverwrite(&std, u)
+	std.merge(u)
 	return std
 }
 
+// merge applies u to s in place, without the copy of Merge. The values of u are shared with s.
+// The locations already known are kept, the elements of the document merged into keep pointing
+// at it.
+func (s *ServiceTemplateDefinition) merge(u ServiceTemplateDefinition) {
+	if len(u.Locations) != 0 {
+		locations := make(map[string]SourceLocation, len(s.Locations)+len(u.Locations))
+		for path, loc := range u.Locations {
+			locations[path] = loc
+		}
+		for path, loc := range s.Locations {
+			locations[path] = loc
+		}
+		s.Locations, u.Locations = locations, nil
+	}
+	// the data types flattened before the merge may be redefined
+	s.dataTypes = nil
+	_ = mergo.MergeWithOverwrite(s, u)
+}
+
 // GetNodeTemplate returns a pointer to a node template given its name
 // its returns nil if not found
 func (s *ServiceTemplateDefinition) GetNodeTemplate(nodeName string) *NodeTemplate {
@@ -135,6 +200,47 @@ func (s *ServiceTemplateDefinition) GetAttribute(node, attr string) *AttributeAs
 	return &output
 }
 
+// PropertyValue evaluates the property "prop" of the node named node and returns its value
+// converted to the type declared by the node type, see CoerceProperty.
+func (s *ServiceTemplateDefinition) PropertyValue(node, prop string) (interface{}, error) {
+	nt := s.GetNodeTemplate(node)
+	if nt == nil {
+		return nil, fmt.Errorf("%w: %s", ErrNodeNotFound, node)
+	}
+	def, ok := nt.Refs.Type.Properties[prop]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s on node %s", ErrPropertyNotFound, prop, node)
+	}
+	pa := nt.Properties[prop]
+	v, err := pa.EvaluateE(s, node)
+	if err != nil {
+		return nil, err
+	}
+	v, err = s.CoerceProperty(def, v)
+	if err != nil {
+		return nil, fmt.Errorf("property %s of node %s: %w", prop, node, err)
+	}
+	return v, nil
+}
+
+// InputValue evaluates the input named prop and returns its value, or its default, converted
+// to the type declared by the input definition, see CoerceProperty.
+func (s *ServiceTemplateDefinition) InputValue(prop string) (interface{}, error) {
+	def, ok := s.TopologyTemplate.Inputs[prop]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrInputNotFound, prop)
+	}
+	v, err := def.Value.EvaluateE(s, "")
+	if err != nil {
+		return nil, err
+	}
+	v, err = s.CoerceProperty(def, v)
+	if err != nil {
+		return nil, fmt.Errorf("input %s: %w", prop, err)
+	}
+	return v, nil
+}
+
 // GetInputValue retrieves an input value from Service Template Definition in
 // the raw form (function evaluation not performed), or actual value after all
 // function evaluation has completed.
@@ -197,6 +303,36 @@ func (s *ServiceTemplateDefinition) nodeTypeHierarchy(name string) []string {
 	return types
 }
 
+func (s *ServiceTemplateDefinition) groupTypeHierarchy(name string) []string {
+	var types []string
+	typeName := name
+	for typeName != "" {
+		if gt, ok := s.GroupTypes[typeName]; ok {
+			types = append(types, typeName)
+			typeName = gt.DerivedFrom
+		} else {
+			typeName = ""
+		}
+	}
+	return types
+}
+
+// nodesOfType returns the sorted names of the node templates of the given type or of a type
+// derived from it.
+func (s *ServiceTemplateDefinition) nodesOfType(typeName string) []string {
+	names := []string{}
+	for name, nt := range s.TopologyTemplate.NodeTemplates {
+		for _, t := range s.nodeTypeHierarchy(nt.Type) {
+			if t == typeName {
+				names = append(names, name)
+				break
+			}
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
 func (s *ServiceTemplateDefinition) findHostNode(name string) *NodeTemplate {
 	nt := s.GetNodeTemplate(name)
 	if nt == nil {
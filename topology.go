@@ -45,9 +45,18 @@ func (t *TopologyTemplateType) reflectProperties() {
 		v.reflectProperties()
 		t.RelationshipTemplates[k] = v
 	}
+
+	for k, v := range t.Groups {
+		v.reflectProperties()
+		t.Groups[k] = v
+	}
 }
 
+// extendFrom extends the templates from their flattened types. The types are copied first, the
+// flattened types of the profiles are shared by the parsings and the templates modify the
+// values they inherit.
 func (t *TopologyTemplateType) extendFrom(ft flatTypes) {
+	ft = t.ownTypes(ft)
 	for k, v := range t.NodeTemplates {
 		v.extendFrom(ft.Nodes[v.Type])
 		v.setName(k)
@@ -59,7 +68,10 @@ func (t *TopologyTemplateType) extendFrom(ft flatTypes) {
 		t.RelationshipTemplates[k] = v
 	}
 
-	// TODO(kenjones): Add support for Groups
+	for k, v := range t.Groups {
+		v.extendFrom(ft.Groups[v.Type])
+		t.Groups[k] = v
+	}
 
 	for i, policies := range t.Policies {
 		for k, v := range policies {
@@ -69,3 +81,37 @@ func (t *TopologyTemplateType) extendFrom(ft flatTypes) {
 		t.Policies[i] = policies
 	}
 }
+
+// ownTypes returns a copy of the flattened types of ft used by the templates, each type is copied
+// once.
+func (t *TopologyTemplateType) ownTypes(ft flatTypes) flatTypes {
+	own := flatTypes{
+		Nodes:         make(map[string]NodeType),
+		Relationships: make(map[string]RelationshipType),
+		Groups:        make(map[string]GroupType),
+		Policies:      make(map[string]PolicyType),
+	}
+	for _, v := range t.NodeTemplates {
+		if _, ok := own.Nodes[v.Type]; !ok {
+			own.Nodes[v.Type], _ = clone(ft.Nodes[v.Type]).(NodeType)
+		}
+	}
+	for _, v := range t.RelationshipTemplates {
+		if _, ok := own.Relationships[v.Type]; !ok {
+			own.Relationships[v.Type], _ = clone(ft.Relationships[v.Type]).(RelationshipType)
+		}
+	}
+	for _, v := range t.Groups {
+		if _, ok := own.Groups[v.Type]; !ok {
+			own.Groups[v.Type], _ = clone(ft.Groups[v.Type]).(GroupType)
+		}
+	}
+	for _, policies := range t.Policies {
+		for _, v := range policies {
+			if _, ok := own.Policies[v.Type]; !ok {
+				own.Policies[v.Type], _ = clone(ft.Policies[v.Type]).(PolicyType)
+			}
+		}
+	}
+	return own
+}
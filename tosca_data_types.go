@@ -18,7 +18,13 @@ package toscalib
 
 // CredentialDefinition as described in appendix C 2.1
 // The Credential type is a complex TOSCA data Type used when describing authorization credentials used to access network accessible resources.
-type CredentialDefinition interface{}
+type CredentialDefinition struct {
+	Protocol  string            `yaml:"protocol,omitempty" json:"protocol,omitempty"`     // The optional protocol name.
+	TokenType string            `yaml:"token_type,omitempty" json:"token_type,omitempty"` // The optional token type, password when not set.
+	Token     string            `yaml:"token,omitempty" json:"token,omitempty"`           // The token used as a credential for authorization or access to a networked resource.
+	Keys      map[string]string `yaml:"keys,omitempty" json:"keys,omitempty"`             // The optional list of protocol-specific keys or assertions.
+	User      string            `yaml:"user,omitempty" json:"user,omitempty"`             // The optional user (name or ID) used for non-token based credentials.
+}
 
 // TimeInterval Datatype defined in Spec v1.2 section 5.3.3
 // The TimeInterval type is a complex TOSCA data Type used when describing a period of time
@@ -1,5 +1,11 @@
 package toscalib
 
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
 const (
 	// Self is ref for a TOSCA orchestrator will interpret this keyword as the Node or Relationship Template
 	// instance that contains the function at the time the function is evaluated
@@ -47,11 +53,70 @@ var Functions = []string{
 	GetArtifactFunc,
 }
 
+// FunctionEvaluator computes the value of a custom function given its arguments. Arguments
+// are passed as parsed, use FunctionContext.Evaluate to evaluate nested function calls.
+type FunctionEvaluator func(fc *FunctionContext, args []interface{}) (interface{}, error)
+
+// FunctionContext provides a custom function with the evaluation in progress
+type FunctionContext struct {
+	Template *ServiceTemplateDefinition
+	// Context is the name of the Node or Relationship Template the function belongs to
+	Context string
+
+	e *evaluator
+}
+
+// Evaluate returns the value of an argument, evaluating it first when it is a function call
+func (fc *FunctionContext) Evaluate(arg interface{}) (interface{}, error) {
+	if pa := newAssignmentFunc(arg); pa != nil {
+		return fc.e.eval(pa, fc.Context)
+	}
+	return arg, nil
+}
+
+var (
+	customFunctionsLock sync.RWMutex
+	customFunctions     = make(map[string]FunctionEvaluator)
+)
+
+// RegisterFunction makes a custom function available to every Service Template parsed afterwards.
+// Registering a name again replaces the previous evaluator, the TOSCA Functions can not be replaced.
+func RegisterFunction(name string, fn FunctionEvaluator) error {
+	if name == "" || fn == nil {
+		return errors.New("a function requires a name and an evaluator")
+	}
+	for _, v := range Functions {
+		if v == name {
+			return fmt.Errorf("%s is a TOSCA function and can not be registered", name)
+		}
+	}
+
+	customFunctionsLock.Lock()
+	defer customFunctionsLock.Unlock()
+	customFunctions[name] = fn
+	return nil
+}
+
+// UnregisterFunction removes a custom function
+func UnregisterFunction(name string) {
+	customFunctionsLock.Lock()
+	defer customFunctionsLock.Unlock()
+	delete(customFunctions, name)
+}
+
+func lookupFunction(name string) (FunctionEvaluator, bool) {
+	customFunctionsLock.RLock()
+	defer customFunctionsLock.RUnlock()
+	fn, ok := customFunctions[name]
+	return fn, ok
+}
+
 func isFunction(f string) bool {
 	for _, v := range Functions {
 		if v == f {
 			return true
 		}
 	}
-	return false
+	_, ok := lookupFunction(f)
+	return ok
 }
@@ -0,0 +1,78 @@
+package toscalib
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestRegisterFunction(t *testing.T) {
+	if err := RegisterFunction(GetInputFunc, nil); err == nil {
+		t.Error("expected an error when registering a function without evaluator")
+	}
+	noop := func(fc *FunctionContext, args []interface{}) (interface{}, error) { return nil, nil }
+	if err := RegisterFunction(GetInputFunc, noop); err == nil {
+		t.Errorf("expected an error when replacing the %s function", GetInputFunc)
+	}
+
+	functions := map[string]FunctionEvaluator{
+		"get_env": func(fc *FunctionContext, args []interface{}) (interface{}, error) {
+			return os.Getenv(fmt.Sprint(args[0])), nil
+		},
+		"join": func(fc *FunctionContext, args []interface{}) (interface{}, error) {
+			var parts []string
+			for _, arg := range args[0].([]interface{}) {
+				v, err := fc.Evaluate(arg)
+				if err != nil {
+					return nil, err
+				}
+				parts = append(parts, fmt.Sprint(v))
+			}
+			return strings.Join(parts, fmt.Sprint(args[1])), nil
+		},
+		"get_secret": func(fc *FunctionContext, args []interface{}) (interface{}, error) {
+			ref := args[0].(map[interface{}]interface{})
+			return fmt.Sprintf("%s:%v/%v", fc.Context, ref["name"], ref["key"]), nil
+		},
+	}
+	for name, fn := range functions {
+		if err := RegisterFunction(name, fn); err != nil {
+			t.Fatal(err)
+		}
+		defer UnregisterFunction(name)
+	}
+	t.Setenv("TOSCALIB_TEST_HOME", "/home/tosca")
+	t.Setenv("TOSCALIB_TEST_HOST", "app")
+
+	fname := "./tests/custom_functions.yaml"
+	var s ServiceTemplateDefinition
+	o, err := os.Open(fname)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = s.Parse(o)
+	if err != nil {
+		t.Log("Error in processing", fname)
+		t.Fatal(err)
+	}
+	s.SetInputValue("domain", "example.com")
+
+	for prop, expected := range map[string]string{
+		"home":     "/home/tosca",
+		"url":      "http://app/",
+		"hosts":    "app,example.com",
+		"password": "app:db/password",
+	} {
+		pa := s.GetProperty("app", prop)
+		v, err := pa.EvaluateE(&s, "app")
+		if err != nil || v != expected {
+			t.Errorf("%s: property %s expected %q, actual %v %v", fname, prop, expected, v, err)
+		}
+	}
+
+	if diags := s.Validate(); diags.HasErrors() {
+		t.Log(fname, "unexpected validation errors", diags)
+		t.Fail()
+	}
+}
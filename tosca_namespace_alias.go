@@ -87,6 +87,13 @@ func parseToscaVersion(s string) (semver.Version, error) {
 
 	// Split into major.minor.patch.pr(-meta)
 	parts := strings.SplitN(s, ".", 4)
+	// major, minor and fix are integers which may have leading zeroes, as in 14.04
+	for i := 0; i < len(parts) && i < 3; i++ {
+		if n, err := strconv.Atoi(parts[i]); err == nil {
+			parts[i] = strconv.Itoa(n)
+		}
+	}
+	s = strings.Join(parts, ".")
 	if len(parts) < 3 {
 		parts = append(parts, "0")
 		s = strings.Join(parts, ".")
@@ -118,29 +125,45 @@ func (v *Version) UnmarshalYAML(unmarshal func(interface{}) error) error {
 		return err
 	}
 
+	ver, err := parseVersion(s)
+	if err != nil {
+		return err
+	}
+	*v = ver
+	return nil
+}
+
+func parseVersion(s string) (Version, error) {
 	// try to use a real semver
 	ver, err := semver.Make(s)
 	if err == nil {
-		v.Major = ver.Major
-		v.Minor = ver.Minor
-		v.Patch = ver.Patch
-		v.Pre = ver.Pre
-		v.Build = ver.Build
-		return nil
+		return Version{ver}, nil
 	}
 
 	ver, err = parseToscaVersion(s)
 	if err == nil {
-		v.Major = ver.Major
-		v.Minor = ver.Minor
-		v.Patch = ver.Patch
-		v.Pre = ver.Pre
-		v.Build = ver.Build
-		return nil
+		return Version{ver}, nil
 	}
-	return fmt.Errorf("Invalid version %v: %s", s, err)
+	return Version{}, fmt.Errorf("Invalid version %v: %s", s, err)
 }
 
+// Type names of the TOSCA primitive and special types as described in Appendix A 1 and A 2
+const (
+	TypeString          = "string"
+	TypeInteger         = "integer"
+	TypeFloat           = "float"
+	TypeBoolean         = "boolean"
+	TypeTimestamp       = "timestamp"
+	TypeNull            = "null"
+	TypeVersion         = "version"
+	TypeRange           = "range"
+	TypeList            = "list"
+	TypeMap             = "map"
+	TypeScalarSize      = "scalar-unit.size"
+	TypeScalarTime      = "scalar-unit.time"
+	TypeScalarFrequency = "scalar-unit.frequency"
+)
+
 // UNBOUNDED A.2.3 TOCSA range type
 const UNBOUNDED uint64 = 9223372036854775807
 
@@ -177,32 +200,121 @@ type Scalar struct {
 	Unit  string
 }
 
-// UnmarshalYAML implements the yaml.Unmarshaler interface
-// Unmarshals a string of the form "scalar unit" into a Scalar, validating that scalar and unit are valid
-func (s *Scalar) UnmarshalYAML(unmarshal func(interface{}) error) error {
-	var sString string
-	err := unmarshal(&sString)
-	if err != nil {
-		return err
+// Units recognized for each of the scalar-unit types, expressed as a multiplier
+// of the base unit (bytes, seconds and hertz respectively).
+var (
+	sizeUnits = map[string]float64{
+		"B":   1,
+		"kB":  1000,
+		"KiB": 1024,
+		"MB":  1000000,
+		"MiB": 1048576,
+		"GB":  1000000000,
+		"GiB": 1073741824,
+		"TB":  1000000000000,
+		"TiB": 1099511627776,
+	}
+	timeUnits = map[string]float64{
+		"d":  86400,
+		"h":  3600,
+		"m":  60,
+		"s":  1,
+		"ms": 0.001,
+		"us": 0.000001,
+		"ns": 0.000000001,
+	}
+	frequencyUnits = map[string]float64{
+		"Hz":  1,
+		"kHz": 1000,
+		"MHz": 1000000,
+		"GHz": 1000000000,
+	}
+)
+
+var scalarRegexp = regexp.MustCompile("^([0-9.]+)[[:blank:]]*([[:alpha:]]+)$")
+
+// canonicalUnit returns the unit as spelled in the specification along with the
+// multiplier table it belongs to. Units are matched case-insensitively.
+func canonicalUnit(unit string) (string, map[string]float64) {
+	for _, units := range []map[string]float64{sizeUnits, timeUnits, frequencyUnits} {
+		for u := range units {
+			if strings.EqualFold(u, unit) {
+				return u, units
+			}
+		}
 	}
+	return "", nil
+}
+
+func parseScalar(str string) (Scalar, error) {
+	var s Scalar
 	// Check if the s has two fields (one for the value, and the other one for the unit)
-	ss := strings.Fields(sString)
+	ss := strings.Fields(str)
 	if len(ss) > 2 {
-		return fmt.Errorf("Not a TOSCA scalar")
+		return s, fmt.Errorf("Not a TOSCA scalar")
 	}
-	re := regexp.MustCompile("^([0-9.]+)[[:blank:]]*(B|kB|KiB|MB|MiB|GB|GiB|TB|TiB|d|h|m|s|ms|us|ns|Hz|kHz|MHz|GHz)$")
-	res := re.FindStringSubmatch(sString)
+	res := scalarRegexp.FindStringSubmatch(strings.TrimSpace(str))
 	if len(res) != 3 {
-		return fmt.Errorf("Tosca type unknown")
+		return s, fmt.Errorf("Tosca type unknown")
+	}
+	unit, _ := canonicalUnit(res[2])
+	if unit == "" {
+		return s, fmt.Errorf("Tosca type unknown")
 	}
 	val, err := strconv.ParseFloat(res[1], 64)
 	if err != nil {
-		return fmt.Errorf("Not a number %v", res[1])
+		return s, fmt.Errorf("Not a number %v", res[1])
 	}
 	s.Value = val
-	s.Unit = res[2]
+	s.Unit = unit
+	return s, nil
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface
+// Unmarshals a string of the form "scalar unit" into a Scalar, validating that scalar and unit are valid
+func (s *Scalar) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var sString string
+	err := unmarshal(&sString)
+	if err != nil {
+		return err
+	}
+	v, err := parseScalar(sString)
+	if err != nil {
+		return err
+	}
+	*s = v
 	return nil
 }
 
+// String returns the scalar in its "scalar unit" form
+func (s Scalar) String() string {
+	return fmt.Sprintf("%s %s", strconv.FormatFloat(s.Value, 'f', -1, 64), s.Unit)
+}
+
+// Kind returns the scalar-unit type (size, time or frequency) matching the unit
+func (s Scalar) Kind() string {
+	_, units := canonicalUnit(s.Unit)
+	switch {
+	case units == nil:
+		return ""
+	case units["B"] != 0:
+		return TypeScalarSize
+	case units["s"] != 0:
+		return TypeScalarTime
+	default:
+		return TypeScalarFrequency
+	}
+}
+
+// BaseValue returns the value of the scalar converted to the base unit of its kind,
+// i.e. bytes for sizes, seconds for times and hertz for frequencies.
+func (s Scalar) BaseValue() float64 {
+	unit, units := canonicalUnit(s.Unit)
+	if units == nil {
+		return s.Value
+	}
+	return s.Value * units[unit]
+}
+
 // Regex type used in the constraint definition (Appendix A 5.2.1)
 type Regex interface{}
@@ -156,6 +156,15 @@ func TestVersion(t *testing.T) {
 	}
 	checkVersion("1", expected, t)
 
+	expected = map[string]string{
+		"major": "14",
+		"minor": "4",
+		"fix":   "0",
+		"rel":   "",
+		"build": "0",
+	}
+	checkVersion("14.04", expected, t)
+
 	var v Version
 	str := "test"
 	data := toBytes(str)
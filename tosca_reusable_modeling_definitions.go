@@ -85,7 +85,7 @@ type DataType struct {
 type RepositoryDefinition struct {
 	Description string               `yaml:"description,omitempty" json:"description,omitempty"` // The optional description for the repository.
 	URL         string               `yaml:"url" json:"url"`                                     // The required URL or network address used to access the repository.
-	Credential  CredentialDefinition `yaml:"credential" json:"credential"`                       // The optional Credential used to authorize access to the repository.
+	Credential  CredentialDefinition `yaml:"credential,omitempty" json:"credential"`             // The optional Credential used to authorize access to the repository.
 }
 
 // UnmarshalYAML is used to match both Simple Notation Example and Full Notation Example
@@ -101,7 +101,7 @@ func (r *RepositoryDefinition) UnmarshalYAML(unmarshal func(interface{}) error)
 	var test2 struct {
 		Description string               `yaml:"description,omitempty" json:"description,omitempty"`
 		URL         string               `yaml:"url" json:"url"`
-		Credential  CredentialDefinition `yaml:"credential" json:"credential"`
+		Credential  CredentialDefinition `yaml:"credential,omitempty" json:"credential"`
 	}
 	err = unmarshal(&test2)
 	if err != nil {
@@ -125,6 +125,7 @@ type ImportDefinition struct {
 	Repository      string `yaml:"repository,omitempty" json:"repository,omitempty"`
 	NamespaceURI    string `yaml:"namespace_uri,omitempty" json:"namespace_uri,omitempty"`
 	NamespacePrefix string `yaml:"namespace_prefix,omitempty" json:"namespace_prefix,omitempty"`
+	Profile         string `yaml:"profile,omitempty" json:"profile,omitempty"` // The name of a registered profile to import instead of a file, see ProfileRegistry.
 }
 
 // UnmarshalYAML is used to match both Simple Notation Example and Full Notation Example
@@ -143,13 +144,15 @@ func (i *ImportDefinition) UnmarshalYAML(unmarshal func(interface{}) error) erro
 		Repository      string `yaml:"repository,omitempty" json:"repository,omitempty"`
 		NamespaceURI    string `yaml:"namespace_uri,omitempty" json:"namespace_uri,omitempty"`
 		NamespacePrefix string `yaml:"namespace_prefix,omitempty" json:"namespace_prefix,omitempty"`
+		Profile         string `yaml:"profile,omitempty" json:"profile,omitempty"`
 	}
 	err = unmarshal(&full)
-	if err == nil && full.File != "" {
+	if err == nil && (full.File != "" || full.Profile != "") {
 		i.File = full.File
 		i.Repository = full.Repository
 		i.NamespaceURI = full.NamespaceURI
 		i.NamespacePrefix = full.NamespacePrefix
+		i.Profile = full.Profile
 		return nil
 	}
 
@@ -178,6 +181,7 @@ func (i *ImportDefinition) UnmarshalYAML(unmarshal func(interface{}) error) erro
 			i.Repository = v.Repository
 			i.NamespaceURI = v.NamespaceURI
 			i.NamespacePrefix = v.NamespacePrefix
+			i.Profile = v.Profile
 			return nil
 		}
 	}
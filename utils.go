@@ -105,29 +105,12 @@ func get(k int, list []interface{}) string {
 	return ""
 }
 
-func remainder(k int, list []interface{}) []interface{} {
-	if len(list) <= k {
-		return make([]interface{}, 0)
-	}
-	return list[k+1:]
-}
-
-func copyFile(src, destDir string) (string, error) {
-	absSrc, err := filepath.Abs(src)
+func writeFile(filename string, data []byte, destDir string) (string, error) {
+	dest, err := filepath.Abs(filepath.Join(destDir, filename))
 	if err != nil {
 		return "", err
 	}
-	srcFilename := filepath.Base(absSrc)
 
-	dest, err := filepath.Abs(filepath.Join(destDir, srcFilename))
-	if err != nil {
-		return "", err
-	}
-
-	data, err := ioutil.ReadFile(absSrc)
-	if err != nil {
-		return "", err
-	}
 	// Write data to dst
 	err = ioutil.WriteFile(dest, data, 0644)
 	if err != nil {
@@ -0,0 +1,496 @@
+package toscalib
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// Severity indicates how serious a Diagnostic is
+type Severity int
+
+// Valid values for Severity
+const (
+	SeverityError Severity = iota
+	SeverityWarning
+)
+
+func (s Severity) String() string {
+	if s == SeverityWarning {
+		return "warning"
+	}
+	return "error"
+}
+
+// Codes identifying the kind of problem reported by a Diagnostic
+const (
+	CodeUnknownNodeType         = "unknown_node_type"
+	CodeUnknownRelationshipType = "unknown_relationship_type"
+	CodeUnknownCapabilityType   = "unknown_capability_type"
+	CodeUnknownCapability       = "unknown_capability"
+	CodeUnknownProperty         = "unknown_property"
+	CodeMissingNodeTemplate     = "missing_node_template"
+	CodeMissingRequiredProperty = "missing_required_property"
+	CodeConstraintViolation     = "constraint_violation"
+	CodeTypeMismatch            = "type_mismatch"
+	CodeInvalidFunction         = "invalid_function"
+	CodeUnknownGroupType        = "unknown_group_type"
+	CodeInvalidGroupMember      = "invalid_group_member"
+)
+
+// Diagnostic describes a single problem found while validating a ServiceTemplateDefinition.
+// Path is the dotted location of the offending element within the document, for example
+// topology_template.node_templates.web.requirements[0].host, and Location is where that
+// element was defined when known.
+type Diagnostic struct {
+	Severity Severity
+	Code     string
+	Path     string
+	Message  string
+	Location SourceLocation
+}
+
+func (d Diagnostic) String() string {
+	if d.Location.IsZero() {
+		return fmt.Sprintf("%s: %s: %s (%s)", d.Severity, d.Path, d.Message, d.Code)
+	}
+	return fmt.Sprintf("%s: %s: %s: %s (%s)", d.Location, d.Severity, d.Path, d.Message, d.Code)
+}
+
+// Diagnostics is the list of problems found while validating a ServiceTemplateDefinition.
+// It satisfies the error interface so it can be returned as is.
+type Diagnostics []Diagnostic
+
+// HasErrors returns true if any Diagnostic has SeverityError
+func (d Diagnostics) HasErrors() bool {
+	for _, diag := range d {
+		if diag.Severity == SeverityError {
+			return true
+		}
+	}
+	return false
+}
+
+// Err returns the Diagnostics as an error if any of them has SeverityError, nil otherwise
+func (d Diagnostics) Err() error {
+	if d.HasErrors() {
+		return d
+	}
+	return nil
+}
+
+func (d Diagnostics) Error() string {
+	lines := make([]string, len(d))
+	for i, diag := range d {
+		lines[i] = diag.String()
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Validate walks the resolved Service Template and reports every problem found at once.
+// It detects unknown types, including the capability types referenced by the node types,
+// requirements targeting missing node templates, missing required properties, constraint
+// violations, invalid function arguments and group members which are missing or not allowed
+// by the group type.
+func (s *ServiceTemplateDefinition) Validate() Diagnostics {
+	v := &validator{std: s}
+
+	v.validateNodeTypes()
+	v.validateNodeTemplates()
+	v.validateRelationshipTemplates()
+	v.validateGroups()
+	v.validateParameters("topology_template.inputs", s.TopologyTemplate.Inputs, "")
+	v.validateParameters("topology_template.outputs", s.TopologyTemplate.Outputs, "")
+
+	return v.diags
+}
+
+type validator struct {
+	std   *ServiceTemplateDefinition
+	diags Diagnostics
+}
+
+func (v *validator) report(sev Severity, code, path, format string, a ...interface{}) {
+	v.diags = append(v.diags, Diagnostic{
+		Severity: sev,
+		Code:     code,
+		Path:     path,
+		Message:  fmt.Sprintf(format, a...),
+		Location: v.std.locate(path),
+	})
+}
+
+func (v *validator) validateNodeTypes() {
+	for _, name := range sortedKeys(v.std.NodeTypes) {
+		nt := v.std.NodeTypes[name]
+		path := "node_types." + name
+
+		for _, capname := range sortedKeys(nt.Capabilities) {
+			if ct := nt.Capabilities[capname].Type; !v.isCapabilityType(ct) {
+				v.report(SeverityError, CodeUnknownCapabilityType, path+".capabilities."+capname+".type",
+					"capability %q of node type %q has unknown capability type %q", capname, name, ct)
+			}
+		}
+
+		for i, reqs := range nt.Requirements {
+			for _, rname := range sortedKeys(reqs) {
+				if ct := reqs[rname].Capability; ct != "" && !v.isCapabilityType(ct) {
+					v.report(SeverityError, CodeUnknownCapabilityType, fmt.Sprintf("%s.requirements[%d].%s.capability", path, i, rname),
+						"requirement %q of node type %q has unknown capability type %q", rname, name, ct)
+				}
+			}
+		}
+	}
+}
+
+func (v *validator) validateNodeTemplates() {
+	for _, name := range sortedKeys(v.std.TopologyTemplate.NodeTemplates) {
+		nt := v.std.TopologyTemplate.NodeTemplates[name]
+		path := "topology_template.node_templates." + name
+
+		if _, ok := v.std.NodeTypes[nt.Type]; !ok {
+			v.report(SeverityError, CodeUnknownNodeType, path+".type",
+				"node template %q has unknown node type %q", name, nt.Type)
+			continue
+		}
+
+		v.validateProperties(path+".properties", nt.Properties, nt.Refs.Type.Properties, name)
+		v.validateAttributes(path+".attributes", nt.Attributes, nt.Properties, name)
+
+		for _, capname := range sortedKeys(nt.Capabilities) {
+			capPath := path + ".capabilities." + capname
+			cd, ok := nt.Refs.Type.Capabilities[capname]
+			if !ok {
+				v.report(SeverityError, CodeUnknownCapability, capPath,
+					"node type %q does not define capability %q", nt.Type, capname)
+				continue
+			}
+			ca := nt.Capabilities[capname]
+			v.validateProperties(capPath+".properties", ca.Properties, cd.Properties, name)
+			v.validateAttributes(capPath+".attributes", ca.Attributes, ca.Properties, name)
+		}
+
+		for i, reqs := range nt.Requirements {
+			for rname, ra := range reqs {
+				v.validateRequirement(fmt.Sprintf("%s.requirements[%d].%s", path, i, rname), rname, ra)
+			}
+		}
+
+		v.validateInterfaces(path+".interfaces", nt.Interfaces, name)
+	}
+}
+
+func (v *validator) validateRequirement(path, name string, ra RequirementAssignment) {
+	var target *NodeTemplate
+	if ra.Node != "" {
+		target = v.std.GetNodeTemplate(ra.Node)
+		if target == nil {
+			// the node may also reference a node type to be selected by the orchestrator
+			if _, ok := v.std.NodeTypes[ra.Node]; !ok {
+				v.report(SeverityError, CodeMissingNodeTemplate, path,
+					"requirement %q targets missing node template %q", name, ra.Node)
+			}
+		}
+	}
+
+	if ra.Capability != "" && !v.isCapabilityType(ra.Capability) {
+		if target == nil || !hasCapability(target, ra.Capability) {
+			v.report(SeverityError, CodeUnknownCapability, path+".capability",
+				"requirement %q references unknown capability %q", name, ra.Capability)
+		}
+	}
+
+	if rel := ra.Relationship.Type; rel != "" {
+		_, isType := v.std.RelationshipTypes[rel]
+		_, isTemplate := v.std.TopologyTemplate.RelationshipTemplates[rel]
+		if !isType && !isTemplate {
+			v.report(SeverityError, CodeUnknownRelationshipType, path+".relationship",
+				"requirement %q has unknown relationship %q", name, rel)
+		}
+	}
+}
+
+func (v *validator) validateRelationshipTemplates() {
+	for _, name := range sortedKeys(v.std.TopologyTemplate.RelationshipTemplates) {
+		rt := v.std.TopologyTemplate.RelationshipTemplates[name]
+		path := "topology_template.relationship_templates." + name
+
+		if _, ok := v.std.RelationshipTypes[rt.Type]; !ok {
+			v.report(SeverityError, CodeUnknownRelationshipType, path+".type",
+				"relationship template %q has unknown relationship type %q", name, rt.Type)
+			continue
+		}
+		v.validateProperties(path+".properties", rt.Properties, flattenRelType(rt.Type, *v.std).Properties, name)
+		v.validateInterfaces(path+".interfaces", rt.Interfaces, name)
+	}
+}
+
+func (v *validator) validateGroups() {
+	for _, name := range sortedKeys(v.std.TopologyTemplate.Groups) {
+		g := v.std.TopologyTemplate.Groups[name]
+		path := "topology_template.groups." + name
+
+		if _, ok := v.std.GroupTypes[g.Type]; !ok {
+			v.report(SeverityError, CodeUnknownGroupType, path+".type",
+				"group %q has unknown group type %q", name, g.Type)
+			continue
+		}
+		v.validateProperties(path+".properties", g.Properties, g.Refs.Type.Properties, name)
+		v.validateInterfaces(path+".interfaces", g.Interfaces, name)
+
+		for i, member := range g.Members {
+			mpath := fmt.Sprintf("%s.members[%d]", path, i)
+			var hierarchy []string
+			if nt, ok := v.std.TopologyTemplate.NodeTemplates[member]; ok {
+				hierarchy = v.std.nodeTypeHierarchy(nt.Type)
+			} else if mg, ok := v.std.TopologyTemplate.Groups[member]; ok && member != name {
+				hierarchy = v.std.groupTypeHierarchy(mg.Type)
+			} else {
+				v.report(SeverityError, CodeMissingNodeTemplate, mpath,
+					"group %q member %q is not a node template or a group", name, member)
+				continue
+			}
+			if !isAllowedMember(g.Refs.Type.Members, hierarchy) {
+				v.report(SeverityError, CodeInvalidGroupMember, mpath,
+					"group type %q does not allow member %q, expected one of %v", g.Type, member, g.Refs.Type.Members)
+			}
+		}
+	}
+}
+
+// isAllowedMember checks if a type hierarchy matches one of the member types of a group type,
+// any member is allowed when the group type does not list them.
+func isAllowedMember(members, hierarchy []string) bool {
+	if len(members) == 0 {
+		return true
+	}
+	for _, m := range members {
+		for _, t := range hierarchy {
+			if m == t {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (v *validator) validateProperties(path string, props map[string]PropertyAssignment, defs map[string]PropertyDefinition, ctx string) {
+	names := make([]string, 0, len(props)+len(defs))
+	for name := range defs {
+		names = append(names, name)
+	}
+	for name := range props {
+		if _, ok := defs[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		ppath := path + "." + name
+		pa, assigned := props[name]
+		def, defined := defs[name]
+
+		if !defined {
+			v.report(SeverityWarning, CodeUnknownProperty, ppath, "property %q is not defined by the type", name)
+			v.validateAssignment(ppath, pa.Assignment, ctx)
+			continue
+		}
+
+		if !assigned || isEmptyAssignment(pa.Assignment) {
+			if def.Required && def.Default == nil {
+				v.report(SeverityError, CodeMissingRequiredProperty, ppath, "required property %q has no value", name)
+			}
+			continue
+		}
+
+		if pa.Function != "" {
+			v.validateAssignment(ppath, pa.Assignment, ctx)
+			continue
+		}
+
+		v.validateValue(ppath, "property", name, def, pa.Value)
+	}
+}
+
+// validateValue reports a value which can not be coerced to the type of its definition
+func (v *validator) validateValue(path, kind, name string, def PropertyDefinition, value interface{}) {
+	if value == nil || def.Type == "" {
+		return
+	}
+	if _, err := v.std.CoerceProperty(def, value); err != nil {
+		code := CodeTypeMismatch
+		var ce *ConstraintError
+		if errors.As(err, &ce) {
+			code = CodeConstraintViolation
+		}
+		v.report(SeverityError, code, path, "%s %q: %v", kind, name, err)
+	}
+}
+
+func (v *validator) validateAttributes(path string, attrs map[string]AttributeAssignment, props map[string]PropertyAssignment, ctx string) {
+	for _, name := range sortedKeys(attrs) {
+		// properties are reflected as attributes, they are already validated
+		if _, ok := props[name]; ok {
+			continue
+		}
+		v.validateAssignment(path+"."+name, attrs[name].Assignment, ctx)
+	}
+}
+
+func (v *validator) validateParameters(path string, params map[string]PropertyDefinition, ctx string) {
+	for _, name := range sortedKeys(params) {
+		p := params[name]
+		ppath := path + "." + name
+		v.validateAssignment(ppath, p.Value.Assignment, ctx)
+
+		if p.Value.Function == "" {
+			v.validateValue(ppath, "parameter", name, p, p.Value.Value)
+		}
+	}
+}
+
+func (v *validator) validateInterfaces(path string, intfs map[string]InterfaceDefinition, ctx string) {
+	for _, name := range sortedKeys(intfs) {
+		intf := intfs[name]
+		ipath := path + "." + name
+		for _, in := range sortedKeys(intf.Inputs) {
+			v.validateAssignment(ipath+".inputs."+in, intf.Inputs[in].Assignment, ctx)
+		}
+
+		for _, op := range sortedKeys(intf.Operations) {
+			inputs := intf.Operations[op].Inputs
+			for _, in := range sortedKeys(inputs) {
+				v.validateAssignment(ipath+"."+op+".inputs."+in, inputs[in].Assignment, ctx)
+			}
+		}
+	}
+}
+
+// validateAssignment checks the arguments of the function of an Assignment, if any,
+// along with any function nested within its arguments.
+func (v *validator) validateAssignment(path string, a Assignment, ctx string) {
+	if a.Function == "" {
+		return
+	}
+
+	bad := func(format string, args ...interface{}) {
+		v.report(SeverityError, CodeInvalidFunction, path, "%s: %s", a.Function, fmt.Sprintf(format, args...))
+	}
+
+	switch a.Function {
+	case ConcatFunc:
+		if len(a.Args) == 0 {
+			bad("requires at least one argument")
+		}
+
+	case TokenFunc:
+		if len(a.Args) != 3 {
+			bad("requires 3 arguments, got %d", len(a.Args))
+		} else if _, ok := a.Args[1].(string); !ok {
+			bad("the token argument must be a string")
+		} else if _, ok := a.Args[2].(int); !ok {
+			bad("the index argument must be an integer")
+		}
+
+	case GetInputFunc:
+		if len(a.Args) != 1 {
+			bad("requires 1 argument, got %d", len(a.Args))
+		} else if name, ok := a.Args[0].(string); !ok {
+			bad("the input name must be a string")
+		} else if _, ok := v.std.TopologyTemplate.Inputs[name]; !ok {
+			bad("input %q is not defined", name)
+		}
+
+	case GetPropFunc, GetAttrFunc, GetArtifactFunc:
+		if len(a.Args) < 2 {
+			bad("requires at least 2 arguments, got %d", len(a.Args))
+		} else {
+			v.validateEntityArg(a, ctx, bad)
+		}
+
+	case GetOpOutputFunc:
+		if len(a.Args) != 4 {
+			bad("requires 4 arguments, got %d", len(a.Args))
+		} else {
+			v.validateEntityArg(a, ctx, bad)
+		}
+
+	case GetNodesOfTypeFunc:
+		if len(a.Args) != 1 {
+			bad("requires 1 argument, got %d", len(a.Args))
+		} else if name, ok := a.Args[0].(string); !ok {
+			bad("the node type must be a string")
+		} else if _, ok := v.std.NodeTypes[name]; !ok {
+			bad("node type %q is not defined", name)
+		}
+	}
+
+	for _, arg := range a.Args {
+		if reflect.ValueOf(arg).Kind() != reflect.Map {
+			continue
+		}
+		if pa := newAssignmentFunc(arg); pa != nil {
+			v.validateAssignment(path, *pa, ctx)
+		}
+	}
+}
+
+func (v *validator) validateEntityArg(a Assignment, ctx string, bad func(string, ...interface{})) {
+	name, ok := a.Args[0].(string)
+	if !ok {
+		bad("the modelable entity name must be a string")
+		return
+	}
+	switch name {
+	case Self, Source, Target, Host:
+		if ctx == "" {
+			bad("%s can only be used within a node or relationship template", name)
+		}
+		return
+	}
+	if _, ok := v.std.TopologyTemplate.NodeTemplates[name]; ok {
+		return
+	}
+	if _, ok := v.std.TopologyTemplate.RelationshipTemplates[name]; ok {
+		return
+	}
+	bad("node template %q does not exist", name)
+}
+
+func (v *validator) isCapabilityType(name string) bool {
+	_, ok := v.std.CapabilityTypes[name]
+	return ok
+}
+
+func hasCapability(nt *NodeTemplate, name string) bool {
+	if _, ok := nt.Refs.Type.Capabilities[name]; ok {
+		return true
+	}
+	_, ok := nt.Capabilities[name]
+	return ok
+}
+
+func isEmptyAssignment(a Assignment) bool {
+	if a.Function != "" || a.Expression.Operator != "" {
+		return false
+	}
+	if a.Value == nil {
+		return true
+	}
+	s, ok := a.Value.(string)
+	return ok && s == ""
+}
+
+// sortedKeys returns the keys of a map indexed by strings in sorted order
+func sortedKeys(m interface{}) []string {
+	keys := reflect.ValueOf(m).MapKeys()
+	names := make([]string, len(keys))
+	for i, k := range keys {
+		names[i] = k.String()
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,126 @@
+package toscalib
+
+import (
+	"os"
+	"testing"
+)
+
+func parseFile(t *testing.T, fname string) ServiceTemplateDefinition {
+	var s ServiceTemplateDefinition
+	o, err := os.Open(fname)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer o.Close()
+	if err = s.Parse(o); err != nil {
+		t.Log("Error in processing", fname)
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestValidate(t *testing.T) {
+	fname := "./tests/tosca_helloworld.yaml"
+	s := parseFile(t, fname)
+	if diags := s.Validate(); len(diags) != 0 {
+		t.Log(fname, "expected no diagnostics, got", diags)
+		t.Fail()
+	}
+}
+
+func TestValidateErrors(t *testing.T) {
+	fname := "./tests/invalids/tosca_validation_errors.yaml"
+	s := parseFile(t, fname)
+
+	expected := []struct {
+		code string
+		path string
+	}{
+		{CodeUnknownCapabilityType, "node_types.my.nodes.Queue.capabilities.messages.type"},
+		{CodeUnknownCapabilityType, "node_types.my.nodes.Queue.requirements[0].broker.capability"},
+		{CodeMissingRequiredProperty, "topology_template.node_templates.db.properties.name"},
+		{CodeInvalidFunction, "topology_template.node_templates.db.properties.port"},
+		{CodeConstraintViolation, "topology_template.node_templates.server.capabilities.host.properties.num_cpus"},
+		{CodeUnknownNodeType, "topology_template.node_templates.unknown.type"},
+		{CodeMissingNodeTemplate, "topology_template.node_templates.web.requirements[0].host"},
+		{CodeInvalidFunction, "topology_template.outputs.cpus"},
+		{CodeInvalidFunction, "topology_template.outputs.server_ip"},
+	}
+
+	diags := s.Validate()
+	if !diags.HasErrors() || diags.Err() == nil {
+		t.Fatal(fname, "expected validation errors")
+	}
+	if len(diags) != len(expected) {
+		t.Log(fname, "expected", len(expected), "diagnostics, got", len(diags), diags)
+		t.Fail()
+	}
+	for _, e := range expected {
+		found := false
+		for _, d := range diags {
+			if d.Code == e.code && d.Path == e.path {
+				found = true
+				break
+			}
+		}
+		if !found {
+			t.Errorf("%s missing diagnostic %s at %s", fname, e.code, e.path)
+		}
+	}
+}
+
+func TestValidateGroups(t *testing.T) {
+	fname := "./tests/tosca_group_members.yaml"
+	s := parseFile(t, fname)
+	if diags := s.Validate(); len(diags) != 0 {
+		t.Log(fname, "expected no diagnostics, got", diags)
+		t.Fail()
+	}
+
+	g := s.TopologyTemplate.Groups["rack_1"]
+	zone := g.Properties["zone"]
+	if v := zone.Evaluate(&s, ""); v != "zone-a" {
+		t.Errorf("%s: rack_1 expected inherited property zone `zone-a`, actual %v", fname, v)
+	}
+	if _, ok := g.Attributes["rack"]; !ok {
+		t.Errorf("%s: rack_1 expected property rack reflected to attributes, actual %v", fname, g.Attributes)
+	}
+	if _, ok := g.Interfaces["Standard"]; !ok {
+		t.Errorf("%s: rack_1 expected interface Standard from tosca.groups.Root, actual %v", fname, g.Interfaces)
+	}
+	if len(g.Refs.Type.Members) != 2 {
+		t.Errorf("%s: rack_1 expected the members of tosca.groups.FailureDomain, actual %v", fname, g.Refs.Type.Members)
+	}
+
+	fname = "./tests/invalids/tosca_group_members_errors.yaml"
+	s = parseFile(t, fname)
+
+	expected := []struct {
+		code string
+		path string
+	}{
+		{CodeMissingNodeTemplate, "topology_template.groups.rack_2.members[0]"},
+		{CodeInvalidGroupMember, "topology_template.groups.rack_2.members[1]"},
+		{CodeMissingRequiredProperty, "topology_template.groups.rack_2.properties.rack"},
+		{CodeTypeMismatch, "topology_template.groups.rack_2.properties.replicas"},
+		{CodeUnknownGroupType, "topology_template.groups.unknown.type"},
+	}
+
+	diags := s.Validate()
+	if len(diags) != len(expected) {
+		t.Log(fname, "expected", len(expected), "diagnostics, got", len(diags), diags)
+		t.Fail()
+	}
+	for _, e := range expected {
+		found := false
+		for _, d := range diags {
+			if d.Code == e.code && d.Path == e.path {
+				found = true
+				break
+			}
+		}
+		if !found {
+			t.Errorf("%s missing diagnostic %s at %s", fname, e.code, e.path)
+		}
+	}
+}
@@ -16,6 +16,18 @@ type StepDefinition struct {
 	Filter     Filter               `yaml:"filter,omitempty" json:"filter,omitempty"`
 }
 
+// Targets evaluates the step target and returns the names of the node templates the step
+// applies to, a target using get_nodes_of_type applies the step to every matching node template.
+func (s *StepDefinition) Targets(std *ServiceTemplateDefinition, wfname string) []string {
+	switch v := s.Target.EvaluateForWorkflow(std, wfname).(type) {
+	case string:
+		return []string{v}
+	case []string:
+		return v
+	}
+	return nil
+}
+
 // ActivityDefinition structure to handle workflow step activity
 type ActivityDefinition struct {
 	SetState      string `yaml:"set_state,omitempty" json:"set_state,omitempty"`